@@ -0,0 +1,163 @@
+package asthlp
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+)
+
+var (
+	// BinaryBigEndian represents the `binary.BigEndian` byte order
+	BinaryBigEndian = SimpleSelector("binary", "BigEndian")
+	// BinaryLittleEndian represents the `binary.LittleEndian` byte order
+	BinaryLittleEndian = SimpleSelector("binary", "LittleEndian")
+)
+
+type (
+	// BinaryField describes the unsigned integer field of the fixed-layout struct, Size is 1, 2, 4 or 8 bytes
+	BinaryField struct {
+		Name string
+		Size int
+	}
+)
+
+// BinaryPutUintFn is a construction of the `<order>.PutUint<bits>` method of the byte order e.g. binary.BigEndian.PutUint32
+func BinaryPutUintFn(order ast.Expr, bits int) CallFunctionDescriber {
+	return MethodOf(order, fmt.Sprintf("PutUint%d", bits), 2, false)
+}
+
+// BinaryUintFn is a construction of the `<order>.Uint<bits>` method of the byte order e.g. binary.BigEndian.Uint32
+func BinaryUintFn(order ast.Expr, bits int) CallFunctionDescriber {
+	return MethodOf(order, fmt.Sprintf("Uint%d", bits), 1, false)
+}
+
+// Shl represents left shift
+//
+//	<x> << <n>
+func Shl(x, n ast.Expr) ast.Expr {
+	return Binary(x, n, token.SHL)
+}
+
+// Shr represents right shift
+//
+//	<x> >> <n>
+func Shr(x, n ast.Expr) ast.Expr {
+	return Binary(x, n, token.SHR)
+}
+
+// BitAnd represents bitwise AND, e.g. applying the mask
+//
+//	<x> & <mask>
+func BitAnd(x, mask ast.Expr) ast.Expr {
+	return Binary(x, mask, token.AND)
+}
+
+// BitOr represents bitwise OR
+//
+//	<x> | <y>
+func BitOr(x, y ast.Expr) ast.Expr {
+	return Binary(x, y, token.OR)
+}
+
+// BitXor represents bitwise XOR
+//
+//	<x> ^ <y>
+func BitXor(x, y ast.Expr) ast.Expr {
+	return Binary(x, y, token.XOR)
+}
+
+// BitAndNot represents bit clear
+//
+//	<x> &^ <mask>
+func BitAndNot(x, mask ast.Expr) ast.Expr {
+	return Binary(x, mask, token.AND_NOT)
+}
+
+// MakeBinaryMarshaler declares the MarshalBinary method that writes the fields one after another with the byte order,
+// the fields are selected from the receiver, so it must be named
+//
+//	func (<recv>) MarshalBinary() ([]byte, error) {
+//	    var b = make([]byte, 3)
+//	    b[0] = m.Flags
+//	    binary.BigEndian.PutUint16(b[1:], m.Length)
+//	    return b, nil
+//	}
+func MakeBinaryMarshaler(recv *ast.Field, order ast.Expr, fields ...BinaryField) ast.Decl {
+	var (
+		b      = ast.NewIdent("b")
+		m      = receiverName(recv, "MarshalBinary")
+		offset = 0
+		stmts  []ast.Stmt
+	)
+	for _, field := range fields {
+		value := Selector(m, field.Name)
+		if field.Size == 1 {
+			stmts = append(stmts, Assign(VarNames{Index(b, IntegerConstant(offset))}, Assignment, value))
+		} else {
+			stmts = append(stmts, CallStmt(Call(
+				BinaryPutUintFn(order, binaryFieldBits(field)),
+				SliceExpr(b, IntegerConstant(offset), nil),
+				value,
+			)))
+		}
+		offset += field.Size
+	}
+	return DeclareFunction(ast.NewIdent("MarshalBinary")).
+		Receiver(recv).
+		Results(Field("", nil, ArrayType(Byte)), Field("", nil, ErrorType)).
+		AppendStmt(Var(VariableValue(b.Name, FreeExpression(Call(MakeFn, ArrayType(Byte), IntegerConstant(offset).Expr()))))).
+		AppendStmt(stmts...).
+		AppendStmt(Return(b, Nil)).
+		Decl()
+}
+
+// MakeBinaryUnmarshaler declares the UnmarshalBinary method that reads the fields one after another with the byte order,
+// the fields are selected from the receiver, so it must be named
+//
+//	func (<recv>) UnmarshalBinary(b []byte) error {
+//	    if len(b) < 3 {
+//	        return fmt.Errorf("expected at least %d bytes, got %d", 3, len(b))
+//	    }
+//	    m.Flags = b[0]
+//	    m.Length = binary.BigEndian.Uint16(b[1:])
+//	    return nil
+//	}
+func MakeBinaryUnmarshaler(recv *ast.Field, order ast.Expr, fields ...BinaryField) ast.Decl {
+	var (
+		b      = ast.NewIdent("b")
+		m      = receiverName(recv, "UnmarshalBinary")
+		offset = 0
+		stmts  []ast.Stmt
+	)
+	for _, field := range fields {
+		var value ast.Expr
+		if field.Size == 1 {
+			value = Index(b, IntegerConstant(offset))
+		} else {
+			value = Call(BinaryUintFn(order, binaryFieldBits(field)), SliceExpr(b, IntegerConstant(offset), nil))
+		}
+		stmts = append(stmts, Assign(VarNames{Selector(m, field.Name)}, Assignment, value))
+		offset += field.Size
+	}
+	var size = IntegerConstant(offset).Expr()
+	return DeclareFunction(ast.NewIdent("UnmarshalBinary")).
+		Receiver(recv).
+		Params(Field(b.Name, nil, ArrayType(Byte))).
+		Results(Field("", nil, ErrorType)).
+		AppendStmt(If(
+			Binary(Call(LengthFn, b), size, token.LSS),
+			Return(Call(FmtErrorfFn, StringConstant("expected at least %d bytes, got %d").Expr(), size, Call(LengthFn, b))),
+		)).
+		AppendStmt(stmts...).
+		AppendStmt(Return(Nil)).
+		Decl()
+}
+
+func binaryFieldBits(field BinaryField) int {
+	switch field.Size {
+	case 2, 4, 8:
+		return field.Size * 8
+	default:
+		panic(fmt.Sprintf("unsupported size %d of the field %s", field.Size, field.Name))
+	}
+}
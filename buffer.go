@@ -0,0 +1,72 @@
+package asthlp
+
+import "go/ast"
+
+var (
+	// StringsBuilder represents the `strings.Builder` struct
+	StringsBuilder = SimpleSelector("strings", "Builder")
+	// BytesBuffer represents the `bytes.Buffer` struct
+	BytesBuffer = SimpleSelector("bytes", "Buffer")
+)
+
+// BufferWriteStringFn is a construction of the `<buf>.WriteString` method of strings.Builder or bytes.Buffer
+func BufferWriteStringFn(buf ast.Expr) CallFunctionDescriber {
+	return MethodOf(buf, "WriteString", 1, false)
+}
+
+// BufferWriteByteFn is a construction of the `<buf>.WriteByte` method of strings.Builder or bytes.Buffer
+func BufferWriteByteFn(buf ast.Expr) CallFunctionDescriber {
+	return MethodOf(buf, "WriteByte", 1, false)
+}
+
+// BufferWriteRuneFn is a construction of the `<buf>.WriteRune` method of strings.Builder or bytes.Buffer
+func BufferWriteRuneFn(buf ast.Expr) CallFunctionDescriber {
+	return MethodOf(buf, "WriteRune", 1, false)
+}
+
+// BufferWriteFn is a construction of the `<buf>.Write` method of strings.Builder or bytes.Buffer
+func BufferWriteFn(buf ast.Expr) CallFunctionDescriber {
+	return MethodOf(buf, "Write", 1, false)
+}
+
+// BufferGrowFn is a construction of the `<buf>.Grow` method of strings.Builder or bytes.Buffer
+func BufferGrowFn(buf ast.Expr) CallFunctionDescriber {
+	return MethodOf(buf, "Grow", 1, false)
+}
+
+// BufferLenFn is a construction of the `<buf>.Len` method of strings.Builder or bytes.Buffer
+func BufferLenFn(buf ast.Expr) CallFunctionDescriber {
+	return MethodOf(buf, "Len", 0, false)
+}
+
+// BufferStringFn is a construction of the `<buf>.String` method of strings.Builder or bytes.Buffer
+func BufferStringFn(buf ast.Expr) CallFunctionDescriber {
+	return MethodOf(buf, "String", 0, false)
+}
+
+// BufferBytesFn is a construction of the `<buf>.Bytes` method of bytes.Buffer
+func BufferBytesFn(buf ast.Expr) CallFunctionDescriber {
+	return MethodOf(buf, "Bytes", 0, false)
+}
+
+// MakeStringBuilding creates the statements that assemble the string from the parts with strings.Builder
+// and returns them with the expression of the result. nil values will be excluded from parts
+//
+//	var <varName> strings.Builder
+//	<varName>.WriteString(<part1>)
+//	<varName>.WriteString(<part2>)
+//
+//	<varName>.String()
+func MakeStringBuilding(varName string, parts ...ast.Expr) ([]ast.Stmt, ast.Expr) {
+	var (
+		buf   = ast.NewIdent(varName)
+		stmts = make([]ast.Stmt, 0, len(parts)+1)
+	)
+	stmts = append(stmts, Var(VariableType(varName, StringsBuilder)))
+	for _, part := range parts {
+		if part != nil {
+			stmts = append(stmts, CallStmt(Call(BufferWriteStringFn(buf), part)))
+		}
+	}
+	return stmts, Call(BufferStringFn(buf))
+}
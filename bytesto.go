@@ -0,0 +1,58 @@
+package asthlp
+
+import "go/ast"
+
+// BytesToFnIn re-points the BytesTo* describer to the package, the empty package refers to the functions
+// declared in the same package with MakeBytesToFuncs
+//
+//	Call(BytesToFnIn(BytesToIntFn, ""), b) // BytesToInt(b)
+func BytesToFnIn(fn CallFunctionDescriber, pkg string) CallFunctionDescriber {
+	var name = fn.FunctionName
+	if sel, ok := name.(*ast.SelectorExpr); ok {
+		name = ast.NewIdent(sel.Sel.Name)
+	}
+	if pkg != "" {
+		name = Selector(ast.NewIdent(pkg), name.(*ast.Ident).Name)
+	}
+	fn.FunctionName = name
+	return fn
+}
+
+// MakeBytesToFuncs declares the functions of BytesToIntFn, BytesToUintFn, BytesToInt64Fn, BytesToUint64Fn and
+// BytesToFloat64Fn parsing the decimal text with strconv, so that the generated package does not depend on utils
+//
+//	func BytesToInt(b []byte) (int, error) {
+//	    return strconv.Atoi(string(b))
+//	}
+//
+//	func BytesToUint(b []byte) (uint, error) {
+//	    v, err := strconv.ParseUint(string(b), 10, 0)
+//	    return uint(v), err
+//	}
+func MakeBytesToFuncs() []ast.Decl {
+	var (
+		b      = ast.NewIdent("b")
+		v      = ast.NewIdent("v")
+		errVar = ast.NewIdent("err")
+		str    = func() ast.Expr {
+			return ExpressionTypeConvert(b, String)
+		}
+		declare = func(fn CallFunctionDescriber, result ast.Expr, body ...ast.Stmt) ast.Decl {
+			return DeclareFunction(BytesToFnIn(fn, "").FunctionName.(*ast.Ident)).
+				Params(Field(b.Name, nil, ArrayType(Byte))).
+				Results(Field("", nil, result), Field("", nil, ErrorType)).
+				AppendStmt(body...).
+				Decl()
+		}
+	)
+	return []ast.Decl{
+		declare(BytesToIntFn, Int, Return(Call(StrconvAtoiFn, str()))),
+		declare(BytesToUintFn, UInt,
+			Assign(VarNames{v, errVar}, Definition, Call(StrconvParseUintFn, str(), IntegerConstant(10).Expr(), Zero)),
+			Return(ExpressionTypeConvert(v, UInt), errVar),
+		),
+		declare(BytesToInt64Fn, Int64, Return(Call(StrconvParseIntFn, str(), IntegerConstant(10).Expr(), IntegerConstant(64).Expr()))),
+		declare(BytesToUint64Fn, UInt64, Return(Call(StrconvParseUintFn, str(), IntegerConstant(10).Expr(), IntegerConstant(64).Expr()))),
+		declare(BytesToFloat64Fn, Float64, Return(Call(StrconvParseFloatFn, str(), IntegerConstant(64).Expr()))),
+	}
+}
@@ -1,6 +1,7 @@
 package asthlp
 
 import (
+	"fmt"
 	"go/ast"
 	"go/token"
 )
@@ -13,20 +14,37 @@ type (
 		MinimumNumberOfArguments int
 		// ExtensibleNumberOfArguments shows that the number of arguments can be increased (notation ...)
 		ExtensibleNumberOfArguments bool
+		// MaximumNumberOfArguments limits the number of extensible arguments, zero means there is no limit
+		MaximumNumberOfArguments int
+		// ArgumentKinds hints the kinds of the leading arguments, the rest of the arguments are not checked
+		ArgumentKinds []ArgumentKind
 	}
+	// ArgumentKind describes what kind of expression is expected as an argument
+	ArgumentKind int8
+)
+
+const (
+	ArgumentAny    ArgumentKind = iota // no checks
+	ArgumentExpr                       // value expression, type literals like []int are rejected
+	ArgumentType                       // type expression e.g. the first argument of `make`
+	ArgumentString                     // string literal e.g. "abc"
 )
 
 var (
 	// NewFn is a construction of the `new` function
-	NewFn = makeFunc(ast.NewIdent("new"), 1, true)
+	NewFn = makeFunc(ast.NewIdent("new"), 1, false).WithArgumentKinds(ArgumentType)
 	// MakeFn is a construction of the `make` function
-	MakeFn = makeFunc(ast.NewIdent("make"), 1, true)
+	MakeFn = makeFunc(ast.NewIdent("make"), 1, true).WithMaximum(3).WithArgumentKinds(ArgumentType)
 	// LengthFn is a construction of the `len` function
 	LengthFn = makeFunc(ast.NewIdent("len"), 1, false)
 	// CapFn is a construction of the `cap` function
 	CapFn = makeFunc(ast.NewIdent("cap"), 1, false)
 	// AppendFn is a construction of the `append` function
 	AppendFn = makeFunc(ast.NewIdent("append"), 1, true)
+	// PanicFn is a construction of the `panic` function
+	PanicFn = makeFunc(ast.NewIdent("panic"), 1, false)
+	// RecoverFn is a construction of the `recover` function
+	RecoverFn = makeFunc(ast.NewIdent("recover"), 0, false)
 
 	// StrconvItoaFn is a construction of the `strconv.Itoa` function
 	StrconvItoaFn = makeFunc(SimpleSelector("strconv", "Itoa"), 1, false)
@@ -54,6 +72,34 @@ var (
 	StringsToLowerFn = makeFunc(SimpleSelector("strings", "ToLower"), 1, false)
 	// StringsJoinFn is a construction of the `strings.Join` function
 	StringsJoinFn = makeFunc(SimpleSelector("strings", "Join"), 2, false)
+	// StringsToUpperFn is a construction of the `strings.ToUpper` function
+	StringsToUpperFn = makeFunc(SimpleSelector("strings", "ToUpper"), 1, false)
+	// StringsCutFn is a construction of the `strings.Cut` function
+	StringsCutFn = makeFunc(SimpleSelector("strings", "Cut"), 2, false)
+	// Utf8RuneCountInStringFn is a construction of the `utf8.RuneCountInString` function
+	Utf8RuneCountInStringFn = makeFunc(SimpleSelector("utf8", "RuneCountInString"), 1, false)
+
+	// CasesTitleFn is a construction of the `cases.Title` function, the replacement of deprecated `strings.Title`
+	//
+	//	cases.Title(language.Und).String(s)
+	CasesTitleFn = makeFunc(SimpleSelector("cases", "Title"), 1, true)
+	// CasesLowerFn is a construction of the `cases.Lower` function
+	CasesLowerFn = makeFunc(SimpleSelector("cases", "Lower"), 1, true)
+	// CasesUpperFn is a construction of the `cases.Upper` function
+	CasesUpperFn = makeFunc(SimpleSelector("cases", "Upper"), 1, true)
+
+	// UnicodeIsUpperFn is a construction of the `unicode.IsUpper` function
+	UnicodeIsUpperFn = makeFunc(SimpleSelector("unicode", "IsUpper"), 1, false)
+	// UnicodeIsLowerFn is a construction of the `unicode.IsLower` function
+	UnicodeIsLowerFn = makeFunc(SimpleSelector("unicode", "IsLower"), 1, false)
+	// UnicodeIsLetterFn is a construction of the `unicode.IsLetter` function
+	UnicodeIsLetterFn = makeFunc(SimpleSelector("unicode", "IsLetter"), 1, false)
+	// UnicodeIsDigitFn is a construction of the `unicode.IsDigit` function
+	UnicodeIsDigitFn = makeFunc(SimpleSelector("unicode", "IsDigit"), 1, false)
+	// UnicodeToUpperFn is a construction of the `unicode.ToUpper` function
+	UnicodeToUpperFn = makeFunc(SimpleSelector("unicode", "ToUpper"), 1, false)
+	// UnicodeToLowerFn is a construction of the `unicode.ToLower` function
+	UnicodeToLowerFn = makeFunc(SimpleSelector("unicode", "ToLower"), 1, false)
 
 	// BytesEqualFoldFn is a construction of the `bytes.EqualFold` function
 	BytesEqualFoldFn = makeFunc(SimpleSelector("bytes", "EqualFold"), 2, false)
@@ -62,12 +108,85 @@ var (
 	// BytesNewBufferFn is a construction of the `bytes.NewBuffer` function
 	BytesNewBufferFn = makeFunc(SimpleSelector("bytes", "NewBuffer"), 1, false)
 
-	// FmtSprintfFn is a construction of the `fmt.Sprintf` function
-	FmtSprintfFn = makeFunc(SimpleSelector("fmt", "Sprintf"), 1, true)
-	// FmtFscanfFn is a construction of the `fmt.Fscanf` function
-	FmtFscanfFn = makeFunc(SimpleSelector("fmt", "Fscanf"), 1, true)
-	// FmtErrorfFn is a construction of the `fmt.Errorf` function
-	FmtErrorfFn = makeFunc(SimpleSelector("fmt", "Errorf"), 1, true)
+	// SortSliceFn is a construction of the `sort.Slice` function
+	SortSliceFn = makeFunc(SimpleSelector("sort", "Slice"), 2, false)
+	// SortSliceStableFn is a construction of the `sort.SliceStable` function
+	SortSliceStableFn = makeFunc(SimpleSelector("sort", "SliceStable"), 2, false)
+	// SortStringsFn is a construction of the `sort.Strings` function
+	SortStringsFn = makeFunc(SimpleSelector("sort", "Strings"), 1, false)
+	// SortIntsFn is a construction of the `sort.Ints` function
+	SortIntsFn = makeFunc(SimpleSelector("sort", "Ints"), 1, false)
+
+	// SlicesContainsFn is a construction of the `slices.Contains` function
+	SlicesContainsFn = makeFunc(SimpleSelector("slices", "Contains"), 2, false)
+	// SlicesIndexFn is a construction of the `slices.Index` function
+	SlicesIndexFn = makeFunc(SimpleSelector("slices", "Index"), 2, false)
+	// SlicesSortFn is a construction of the `slices.Sort` function
+	SlicesSortFn = makeFunc(SimpleSelector("slices", "Sort"), 1, false)
+	// SlicesSortFuncFn is a construction of the `slices.SortFunc` function
+	SlicesSortFuncFn = makeFunc(SimpleSelector("slices", "SortFunc"), 2, false)
+	// SlicesEqualFn is a construction of the `slices.Equal` function
+	SlicesEqualFn = makeFunc(SimpleSelector("slices", "Equal"), 2, false)
+	// SlicesCollectFn is a construction of the `slices.Collect` function
+	SlicesCollectFn = makeFunc(SimpleSelector("slices", "Collect"), 1, false)
+	// MapsKeysFn is a construction of the `maps.Keys` function
+	MapsKeysFn = makeFunc(SimpleSelector("maps", "Keys"), 1, false)
+	// MapsValuesFn is a construction of the `maps.Values` function
+	MapsValuesFn = makeFunc(SimpleSelector("maps", "Values"), 1, false)
+
+	// FmtSprintFn is a construction of the `fmt.Sprint` function
+	FmtSprintFn = makeFunc(SimpleSelector("fmt", "Sprint"), 0, true)
+	// FmtSprintfFn is a construction of the `fmt.Sprintf` function, the format must be a string literal
+	FmtSprintfFn = makeFunc(SimpleSelector("fmt", "Sprintf"), 1, true).WithArgumentKinds(ArgumentString)
+	// FmtFscanfFn is a construction of the `fmt.Fscanf` function, the format must be a string literal
+	FmtFscanfFn = makeFunc(SimpleSelector("fmt", "Fscanf"), 1, true).WithArgumentKinds(ArgumentExpr, ArgumentString)
+	// FmtErrorfFn is a construction of the `fmt.Errorf` function, the format must be a string literal
+	FmtErrorfFn = makeFunc(SimpleSelector("fmt", "Errorf"), 1, true).WithArgumentKinds(ArgumentString)
+
+	// ErrorsIsFn is a construction of the `errors.Is` function
+	ErrorsIsFn = makeFunc(SimpleSelector("errors", "Is"), 2, false)
+	// ErrorsAsFn is a construction of the `errors.As` function
+	ErrorsAsFn = makeFunc(SimpleSelector("errors", "As"), 2, false)
+	// ErrorsNewFn is a construction of the `errors.New` function
+	ErrorsNewFn = makeFunc(SimpleSelector("errors", "New"), 1, false).WithArgumentKinds(ArgumentExpr)
+	// ErrorsJoinFn is a construction of the `errors.Join` function
+	ErrorsJoinFn = makeFunc(SimpleSelector("errors", "Join"), 0, true)
+	// ErrorsUnwrapFn is a construction of the `errors.Unwrap` function
+	ErrorsUnwrapFn = makeFunc(SimpleSelector("errors", "Unwrap"), 1, false)
+
+	// ContextBackgroundFn is a construction of the `context.Background` function
+	ContextBackgroundFn = makeFunc(SimpleSelector("context", "Background"), 0, false)
+	// ContextTODOFn is a construction of the `context.TODO` function
+	ContextTODOFn = makeFunc(SimpleSelector("context", "TODO"), 0, false)
+	// ContextWithTimeoutFn is a construction of the `context.WithTimeout` function
+	ContextWithTimeoutFn = makeFunc(SimpleSelector("context", "WithTimeout"), 2, false)
+	// ContextWithDeadlineFn is a construction of the `context.WithDeadline` function
+	ContextWithDeadlineFn = makeFunc(SimpleSelector("context", "WithDeadline"), 2, false)
+	// ContextWithCancelFn is a construction of the `context.WithCancel` function
+	ContextWithCancelFn = makeFunc(SimpleSelector("context", "WithCancel"), 1, false)
+	// ContextWithValueFn is a construction of the `context.WithValue` function
+	ContextWithValueFn = makeFunc(SimpleSelector("context", "WithValue"), 3, false)
+	// CtxValueFn is a construction of the `ctx.Value` function
+	CtxValueFn = makeFunc(SimpleSelector("ctx", "Value"), 1, false)
+	// CtxDoneFn is a construction of the `ctx.Done` function
+	CtxDoneFn = makeFunc(SimpleSelector("ctx", "Done"), 0, false)
+	// CtxErrFn is a construction of the `ctx.Err` function
+	CtxErrFn = makeFunc(SimpleSelector("ctx", "Err"), 0, false)
+
+	// IoCopyFn is a construction of the `io.Copy` function
+	IoCopyFn = makeFunc(SimpleSelector("io", "Copy"), 2, false)
+	// IoReadAllFn is a construction of the `io.ReadAll` function
+	IoReadAllFn = makeFunc(SimpleSelector("io", "ReadAll"), 1, false)
+	// OsOpenFn is a construction of the `os.Open` function
+	OsOpenFn = makeFunc(SimpleSelector("os", "Open"), 1, false)
+	// OsCreateFn is a construction of the `os.Create` function
+	OsCreateFn = makeFunc(SimpleSelector("os", "Create"), 1, false)
+	// OsReadFileFn is a construction of the `os.ReadFile` function
+	OsReadFileFn = makeFunc(SimpleSelector("os", "ReadFile"), 1, false)
+	// OsWriteFileFn is a construction of the `os.WriteFile` function
+	OsWriteFileFn = makeFunc(SimpleSelector("os", "WriteFile"), 3, false)
+	// FilepathJoinFn is a construction of the `filepath.Join` function
+	FilepathJoinFn = makeFunc(SimpleSelector("filepath", "Join"), 0, true)
 
 	// JsonUnmarshal is a construction of the `json.Unmarshall` function
 	JsonUnmarshal = makeFunc(SimpleSelector("json", "Unmarshal"), 2, false)
@@ -80,9 +199,39 @@ var (
 
 	// TimeNowFn is a construction of the `time.Now` function
 	TimeNowFn = makeFunc(SimpleSelector("time", "Now"), 0, false)
+	// TimeSinceFn is a construction of the `time.Since` function
+	TimeSinceFn = makeFunc(SimpleSelector("time", "Since"), 1, false)
+	// TimeUntilFn is a construction of the `time.Until` function
+	TimeUntilFn = makeFunc(SimpleSelector("time", "Until"), 1, false)
+	// TimeParseFn is a construction of the `time.Parse` function
+	TimeParseFn = makeFunc(SimpleSelector("time", "Parse"), 2, false)
+	// TimeParseDurationFn is a construction of the `time.ParseDuration` function
+	TimeParseDurationFn = makeFunc(SimpleSelector("time", "ParseDuration"), 1, false)
+	// TimeUnixFn is a construction of the `time.Unix` function
+	TimeUnixFn = makeFunc(SimpleSelector("time", "Unix"), 2, false)
+	// TimeNewTimerFn is a construction of the `time.NewTimer` function
+	TimeNewTimerFn = makeFunc(SimpleSelector("time", "NewTimer"), 1, false)
+	// TimeNewTickerFn is a construction of the `time.NewTicker` function
+	TimeNewTickerFn = makeFunc(SimpleSelector("time", "NewTicker"), 1, false)
+	// TimeAfterFn is a construction of the `time.After` function
+	TimeAfterFn = makeFunc(SimpleSelector("time", "After"), 1, false)
+	// TimeSleepFn is a construction of the `time.Sleep` function
+	TimeSleepFn = makeFunc(SimpleSelector("time", "Sleep"), 1, false)
 
 	// DbQueryFn is a construction of the `db.Query` function
 	DbQueryFn = makeFunc(SimpleSelector("db", "Query"), 1, true)
+	// DbQueryContextFn is a construction of the `db.QueryContext` function
+	DbQueryContextFn = makeFunc(SimpleSelector("db", "QueryContext"), 2, true)
+	// DbQueryRowContextFn is a construction of the `db.QueryRowContext` function
+	DbQueryRowContextFn = makeFunc(SimpleSelector("db", "QueryRowContext"), 2, true)
+	// DbExecContextFn is a construction of the `db.ExecContext` function
+	DbExecContextFn = makeFunc(SimpleSelector("db", "ExecContext"), 2, true)
+	// DbBeginTxFn is a construction of the `db.BeginTx` function
+	DbBeginTxFn = makeFunc(SimpleSelector("db", "BeginTx"), 2, false)
+	// TxCommitFn is a construction of the `tx.Commit` function
+	TxCommitFn = makeFunc(SimpleSelector("tx", "Commit"), 0, false)
+	// TxRollbackFn is a construction of the `tx.Rollback` function
+	TxRollbackFn = makeFunc(SimpleSelector("tx", "Rollback"), 0, false)
 	// RowsNextFn is a construction of the `rows.Next` function
 	RowsNextFn = makeFunc(SimpleSelector("rows", "Next"), 0, false)
 	// RowsErrFn is a construction of the `rows.Err` function
@@ -90,18 +239,83 @@ var (
 	// RowsScanFn is a construction of the `rows.Scan` function
 	RowsScanFn = makeFunc(SimpleSelector("rows", "Scan"), 1, true)
 
-	// BytesToIntFn represents utils.BytesToInt function
+	// BytesToIntFn represents utils.BytesToInt function, see MakeBytesToFuncs and BytesToFnIn to declare it in place
 	BytesToIntFn = makeFunc(SimpleSelector("utils", "BytesToInt"), 1, false)
-	// BytesToUintFn represents utils.BytesToUint function
+	// BytesToUintFn represents utils.BytesToUint function, see MakeBytesToFuncs and BytesToFnIn to declare it in place
 	BytesToUintFn = makeFunc(SimpleSelector("utils", "BytesToUint"), 1, false)
-	// BytesToInt64Fn represents utils.BytesToInt64 function
+	// BytesToInt64Fn represents utils.BytesToInt64 function, see MakeBytesToFuncs and BytesToFnIn to declare it in place
 	BytesToInt64Fn = makeFunc(SimpleSelector("utils", "BytesToInt64"), 1, false)
-	// BytesToUint64Fn represents utils.BytesToUint64 function
+	// BytesToUint64Fn represents utils.BytesToUint64 function, see MakeBytesToFuncs and BytesToFnIn to declare it in place
 	BytesToUint64Fn = makeFunc(SimpleSelector("utils", "BytesToUint64"), 1, false)
-	// BytesToFloat64Fn represents utils.BytesToFloat64 function
+	// BytesToFloat64Fn represents utils.BytesToFloat64 function, see MakeBytesToFuncs and BytesToFnIn to declare it in place
 	BytesToFloat64Fn = makeFunc(SimpleSelector("utils", "BytesToFloat64"), 1, false)
 )
 
+// CtxValueMethod is a construction of the `<ctx>.Value` method of context.Context called on the given receiver
+func CtxValueMethod(ctx ast.Expr) CallFunctionDescriber {
+	return MethodOf(ctx, "Value", 1, false)
+}
+
+// CtxDoneMethod is a construction of the `<ctx>.Done` method of context.Context called on the given receiver
+func CtxDoneMethod(ctx ast.Expr) CallFunctionDescriber {
+	return MethodOf(ctx, "Done", 0, false)
+}
+
+// CtxErrMethod is a construction of the `<ctx>.Err` method of context.Context called on the given receiver
+func CtxErrMethod(ctx ast.Expr) CallFunctionDescriber {
+	return MethodOf(ctx, "Err", 0, false)
+}
+
+// DbQueryMethod is a construction of the `<db>.Query` method of sql.DB called on the given receiver
+func DbQueryMethod(db ast.Expr) CallFunctionDescriber {
+	return MethodOf(db, "Query", 1, true)
+}
+
+// DbQueryContextMethod is a construction of the `<db>.QueryContext` method of sql.DB called on the given receiver
+func DbQueryContextMethod(db ast.Expr) CallFunctionDescriber {
+	return MethodOf(db, "QueryContext", 2, true)
+}
+
+// DbQueryRowContextMethod is a construction of the `<db>.QueryRowContext` method of sql.DB called on the given receiver
+func DbQueryRowContextMethod(db ast.Expr) CallFunctionDescriber {
+	return MethodOf(db, "QueryRowContext", 2, true)
+}
+
+// DbExecContextMethod is a construction of the `<db>.ExecContext` method of sql.DB called on the given receiver
+func DbExecContextMethod(db ast.Expr) CallFunctionDescriber {
+	return MethodOf(db, "ExecContext", 2, true)
+}
+
+// DbBeginTxMethod is a construction of the `<db>.BeginTx` method of sql.DB called on the given receiver
+func DbBeginTxMethod(db ast.Expr) CallFunctionDescriber {
+	return MethodOf(db, "BeginTx", 2, false)
+}
+
+// TxCommitMethod is a construction of the `<tx>.Commit` method of sql.Tx called on the given receiver
+func TxCommitMethod(tx ast.Expr) CallFunctionDescriber {
+	return MethodOf(tx, "Commit", 0, false)
+}
+
+// TxRollbackMethod is a construction of the `<tx>.Rollback` method of sql.Tx called on the given receiver
+func TxRollbackMethod(tx ast.Expr) CallFunctionDescriber {
+	return MethodOf(tx, "Rollback", 0, false)
+}
+
+// RowsNextMethod is a construction of the `<rows>.Next` method of sql.Rows called on the given receiver
+func RowsNextMethod(rows ast.Expr) CallFunctionDescriber {
+	return MethodOf(rows, "Next", 0, false)
+}
+
+// RowsErrMethod is a construction of the `<rows>.Err` method of sql.Rows called on the given receiver
+func RowsErrMethod(rows ast.Expr) CallFunctionDescriber {
+	return MethodOf(rows, "Err", 0, false)
+}
+
+// RowsScanMethod is a construction of the `<rows>.Scan` method of sql.Rows called on the given receiver
+func RowsScanMethod(rows ast.Expr) CallFunctionDescriber {
+	return MethodOf(rows, "Scan", 1, true)
+}
+
 func makeFunc(f ast.Expr, m int, e bool) CallFunctionDescriber {
 	return CallFunctionDescriber{
 		FunctionName:                f,
@@ -110,6 +324,13 @@ func makeFunc(f ast.Expr, m int, e bool) CallFunctionDescriber {
 	}
 }
 
+// MethodOf describes the method of an arbitrary receiver expression
+//
+//	<recv>.<name>(...)
+func MethodOf(recv ast.Expr, name string, minArgs int, extensible bool) CallFunctionDescriber {
+	return makeFunc(Selector(recv, name), minArgs, extensible)
+}
+
 func InlineFunc(f ast.Expr) CallFunctionDescriber {
 	return CallFunctionDescriber{
 		FunctionName:                f,
@@ -118,6 +339,30 @@ func InlineFunc(f ast.Expr) CallFunctionDescriber {
 	}
 }
 
+// WithMaximum limits the number of extensible arguments
+func (c CallFunctionDescriber) WithMaximum(maximum int) CallFunctionDescriber {
+	c.MaximumNumberOfArguments = maximum
+	return c
+}
+
+// WithArgumentKinds hints the kinds of the leading arguments
+func (c CallFunctionDescriber) WithArgumentKinds(kinds ...ArgumentKind) CallFunctionDescriber {
+	c.ArgumentKinds = kinds
+	return c
+}
+
+func (c CallFunctionDescriber) checkArgs(args []ast.Expr) {
+	c.checkArgsCount(len(args))
+	for i, kind := range c.ArgumentKinds {
+		if i >= len(args) {
+			break
+		}
+		if !kind.matches(args[i]) {
+			panic(fmt.Sprintf("argument %d is not a %s", i+1, kind))
+		}
+	}
+}
+
 func (c CallFunctionDescriber) checkArgsCount(a int) {
 	if c.MinimumNumberOfArguments > a {
 		panic("the minimum number of arguments has not been reached")
@@ -125,11 +370,46 @@ func (c CallFunctionDescriber) checkArgsCount(a int) {
 	if !c.ExtensibleNumberOfArguments && a > c.MinimumNumberOfArguments {
 		panic("the maximum number of arguments exceeded")
 	}
+	if c.MaximumNumberOfArguments > 0 && a > c.MaximumNumberOfArguments {
+		panic("the maximum number of arguments exceeded")
+	}
+}
+
+func (k ArgumentKind) String() string {
+	switch k {
+	case ArgumentExpr:
+		return "value expression"
+	case ArgumentType:
+		return "type expression"
+	case ArgumentString:
+		return "string literal"
+	default:
+		return "expression"
+	}
+}
+
+func (k ArgumentKind) matches(arg ast.Expr) bool {
+	switch k {
+	case ArgumentExpr:
+		switch arg.(type) {
+		case *ast.ArrayType, *ast.MapType, *ast.ChanType, *ast.FuncType, *ast.InterfaceType, *ast.StructType:
+			return false
+		}
+	case ArgumentType:
+		switch arg.(type) {
+		case *ast.BasicLit, *ast.CompositeLit, *ast.CallExpr, *ast.BinaryExpr, *ast.UnaryExpr, *ast.FuncLit:
+			return false
+		}
+	case ArgumentString:
+		lit, ok := arg.(*ast.BasicLit)
+		return ok && lit.Kind == token.STRING
+	}
+	return true
 }
 
 // DeferCall represents a deferred function call statement
 func DeferCall(fn CallFunctionDescriber, args ...ast.Expr) ast.Stmt {
-	fn.checkArgsCount(len(args))
+	fn.checkArgs(args)
 	return &ast.DeferStmt{
 		Call: &ast.CallExpr{
 			Fun:  fn.FunctionName,
@@ -140,7 +420,7 @@ func DeferCall(fn CallFunctionDescriber, args ...ast.Expr) ast.Stmt {
 
 // Call represents a function call expression
 func Call(fn CallFunctionDescriber, args ...ast.Expr) *ast.CallExpr {
-	fn.checkArgsCount(len(args))
+	fn.checkArgs(args)
 	return &ast.CallExpr{
 		Fun:      fn.FunctionName,
 		Args:     args,
@@ -150,7 +430,7 @@ func Call(fn CallFunctionDescriber, args ...ast.Expr) *ast.CallExpr {
 
 // CallEllipsis represents a function call expression with ellipsis after the last argument
 func CallEllipsis(fn CallFunctionDescriber, args ...ast.Expr) *ast.CallExpr {
-	fn.checkArgsCount(len(args))
+	fn.checkArgs(args)
 	return &ast.CallExpr{
 		Fun:      fn.FunctionName,
 		Args:     args,
@@ -161,3 +441,44 @@ func CallEllipsis(fn CallFunctionDescriber, args ...ast.Expr) *ast.CallExpr {
 func CallStmt(x *ast.CallExpr) ast.Stmt {
 	return &ast.ExprStmt{X: x}
 }
+
+type (
+	// MethodDescriber describes the method of the receiver, like BufferWriteStringFn
+	MethodDescriber func(recv ast.Expr) CallFunctionDescriber
+	// CallChain builds fluent call chains, the arguments of each step are checked with its describer
+	CallChain interface {
+		Expression
+		Then(method MethodDescriber, args ...ast.Expr) CallChain
+	}
+	callChain struct {
+		x ast.Expr
+	}
+)
+
+// Method describes the method with the name for use in call chains
+func Method(name string, minArgs int, extensible bool) MethodDescriber {
+	return func(recv ast.Expr) CallFunctionDescriber {
+		return MethodOf(recv, name, minArgs, extensible)
+	}
+}
+
+// Chain starts the call chain from the expression
+//
+//	Chain(ast.NewIdent("client")).
+//	    Then(Method("Get", 1, false), url).
+//	    Then(Method("WithHeader", 2, false), key, value).
+//	    Then(Method("Do", 1, false), ctx).
+//	    Expr() // client.Get(url).WithHeader(key, value).Do(ctx)
+func Chain(x ast.Expr) CallChain {
+	return callChain{x: x}
+}
+
+// Then calls the method on the result of the previous step
+func (c callChain) Then(method MethodDescriber, args ...ast.Expr) CallChain {
+	return callChain{x: Call(method(c.x), args...)}
+}
+
+// Expr returns the expression of the last step
+func (c callChain) Expr() ast.Expr {
+	return c.x
+}
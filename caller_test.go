@@ -0,0 +1,59 @@
+package asthlp
+
+import (
+	"go/ast"
+	"testing"
+)
+
+func TestCall_ArgumentKinds(t *testing.T) {
+	var tests = []struct {
+		name   string
+		fn     CallFunctionDescriber
+		args   []ast.Expr
+		panics bool
+	}{
+		{
+			name: "format_literal",
+			fn:   FmtErrorfFn,
+			args: []ast.Expr{StringConstant("value %v").Expr(), ast.NewIdent("v")},
+		},
+		{
+			name:   "format_variable",
+			fn:     FmtSprintfFn,
+			args:   []ast.Expr{ast.NewIdent("format")},
+			panics: true,
+		},
+		{
+			name: "pattern_literal",
+			fn:   RegexpMustCompileFn,
+			args: []ast.Expr{regexpLiteral(`^\d+$`)},
+		},
+		{
+			name:   "pattern_variable",
+			fn:     RegexpMustCompileFn,
+			args:   []ast.Expr{ast.NewIdent("pattern")},
+			panics: true,
+		},
+		{
+			name: "message_variable",
+			fn:   ErrorsNewFn,
+			args: []ast.Expr{ast.NewIdent("message")},
+		},
+		{
+			name:   "message_type",
+			fn:     ErrorsNewFn,
+			args:   []ast.Expr{ArrayType(Byte)},
+			panics: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); (r != nil) != tt.panics {
+					t.Errorf("Call() panic = %v, wantPanic %v", r, tt.panics)
+				}
+			}()
+			Call(tt.fn, tt.args...)
+		})
+	}
+}
@@ -75,11 +75,26 @@ var (
 	// ContextType represents the `context.Context` interface
 	ContextType = SimpleSelector("context", "Context")
 
+	// IoReader represents the `io.Reader` interface
+	IoReader = SimpleSelector("io", "Reader")
+
 	// TimeTime represents the `time.Time` struct
 	TimeTime = SimpleSelector("time", "Time")
 
+	// SqlDB represents the `sql.DB` struct
+	SqlDB = SimpleSelector("sql", "DB")
+
+	// SqlTx represents the `sql.Tx` struct
+	SqlTx = SimpleSelector("sql", "Tx")
+
+	// DriverValue represents the `driver.Value` type
+	DriverValue = SimpleSelector("driver", "Value")
+
 	// ErrorType represents the `error` interface
 	ErrorType = ast.NewIdent("error")
+
+	// LanguageUnd represents the `language.Und` tag, use it with CasesTitleFn
+	LanguageUnd = SimpleSelector("language", "Und")
 )
 
 // NewIdent creates new ast.Ident
@@ -118,12 +133,8 @@ func makeImportSpec(imports map[string]string) []ast.Spec {
 
 // CommentGroup wraps the lines in the ast.CommentGroup structure. Returns nil if arguments is omitted or empty
 func CommentGroup(comments ...string) *ast.CommentGroup {
-	if len(comments) == 0 {
+	if len(truncateEmpty(comments)) == 0 {
 		return nil
-	} else {
-		if len(comments) == 1 && strings.TrimSpace(comments[0]) == "" {
-			return nil
-		}
 	}
 	var prefChar = "\n// "
 	var g ast.CommentGroup
@@ -139,6 +150,9 @@ func CommentGroup(comments ...string) *ast.CommentGroup {
 func CommentGroupWithTag(tag string, comments ...string) *ast.CommentGroup {
 	g := CommentGroup(comments...)
 	if tag != "" {
+		if g == nil {
+			g = &ast.CommentGroup{}
+		}
 		g.List = append(g.List, &ast.Comment{Text: "//" + tag, Slash: 1})
 	}
 	return g
@@ -311,10 +325,19 @@ func KeyValueExpr(key string, val ast.Expr) *ast.KeyValueExpr {
 type assignToken int
 
 const (
-	Assignment  assignToken = iota + 1 // =
-	Incremental                        // +=
-	Decremental                        // -=
-	Definition                         // :=
+	Assignment     assignToken = iota + 1 // =
+	Incremental                           // +=
+	Decremental                           // -=
+	Definition                            // :=
+	Multiplicative                        // *=
+	Divisional                            // /=
+	Remainder                             // %=
+	BitwiseAnd                            // &=
+	BitwiseOr                             // |=
+	BitwiseXor                            // ^=
+	ShiftLeft                             // <<=
+	ShiftRight                            // >>=
+	BitClear                              // &^=
 )
 
 func (t assignToken) token() token.Token {
@@ -327,6 +350,24 @@ func (t assignToken) token() token.Token {
 		return token.SUB_ASSIGN
 	case Definition:
 		return token.DEFINE
+	case Multiplicative:
+		return token.MUL_ASSIGN
+	case Divisional:
+		return token.QUO_ASSIGN
+	case Remainder:
+		return token.REM_ASSIGN
+	case BitwiseAnd:
+		return token.AND_ASSIGN
+	case BitwiseOr:
+		return token.OR_ASSIGN
+	case BitwiseXor:
+		return token.XOR_ASSIGN
+	case ShiftLeft:
+		return token.SHL_ASSIGN
+	case ShiftRight:
+		return token.SHR_ASSIGN
+	case BitClear:
+		return token.AND_NOT_ASSIGN
 	default:
 		panic("unknown assignment token")
 	}
@@ -0,0 +1,43 @@
+package asthlp
+
+import (
+	"go/ast"
+	"go/token"
+	"testing"
+)
+
+func fileWithFieldDoc(doc *ast.CommentGroup) *ast.File {
+	var field = Field("A", nil, Int)
+	field.Doc = doc
+	return DeclareFile("p").AppendDecl(&ast.GenDecl{
+		Tok: token.TYPE,
+		Specs: []ast.Spec{&ast.TypeSpec{
+			Name: ast.NewIdent("T"),
+			Type: &ast.StructType{Fields: &ast.FieldList{List: []*ast.Field{field}}},
+		}},
+	}).File()
+}
+
+func TestCommentGroupWithTag(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		if g := CommentGroupWithTag(""); g != nil {
+			t.Errorf("expected nil, got %d comments", len(g.List))
+		}
+		if g := CommentGroupWithTag("", "", " "); g != nil {
+			t.Errorf("expected nil for blank lines, got %d comments", len(g.List))
+		}
+	})
+	t.Run("lines_and_tag", func(t *testing.T) {
+		assertRendered(
+			t,
+			fileWithFieldDoc(CommentGroupWithTag("easyjson:skip", "A is a")),
+			"package p\n\ntype T struct {\n\t// A is a\n\t//easyjson:skip\n\tA int\n}\n",
+		)
+	})
+	t.Run("tag_only", func(t *testing.T) {
+		var g = CommentGroupWithTag("easyjson:skip")
+		if g == nil || len(g.List) != 1 || g.List[0].Text != "//easyjson:skip" {
+			t.Fatalf("expected the single tag comment, got %#v", g)
+		}
+	})
+}
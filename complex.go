@@ -77,6 +77,61 @@ func MakeCallReturnIfError(varName ast.Expr, callExpr *ast.CallExpr) ast.Stmt {
 	}
 }
 
+// MakeErrorsIsCheck creates the branch executed if the error matches the target
+//
+//	if errors.Is(<errExpr>, <targetExpr>) {
+//	    <body>
+//	}
+func MakeErrorsIsCheck(errExpr, targetExpr ast.Expr, body ...ast.Stmt) ast.Stmt {
+	return If(Call(ErrorsIsFn, errExpr, targetExpr), body...)
+}
+
+// MakeErrorsAsCheck creates the branch executed if the error can be assigned to the target variable
+//
+//	if errors.As(<errExpr>, &<targetVar>) {
+//	    <body>
+//	}
+func MakeErrorsAsCheck(errExpr, targetVar ast.Expr, body ...ast.Stmt) ast.Stmt {
+	return If(Call(ErrorsAsFn, errExpr, Ref(targetVar)), body...)
+}
+
+// MakeContextWithCancel creates the derived context and defers its cancellation,
+// use it with ContextWithTimeoutFn, ContextWithDeadlineFn or ContextWithCancelFn
+//
+//	<ctxVar>, cancel := callExpr()
+//	defer cancel()
+func MakeContextWithCancel(ctxVar string, callExpr *ast.CallExpr) []ast.Stmt {
+	var cancel = ast.NewIdent("cancel")
+	return []ast.Stmt{
+		Assign(VarNames{ast.NewIdent(ctxVar), cancel}, Definition, callExpr),
+		DeferCall(InlineFunc(cancel)),
+	}
+}
+
+// MakeOpenDeferClose opens the file with the call like os.Open or os.Create and closes it at the end of the function
+//
+//	<varName>, err := openCall()
+//	if err != nil {
+//	    <body>
+//	}
+//	defer <varName>.Close()
+//
+// body defaults to `return err`
+func MakeOpenDeferClose(varName string, openCall *ast.CallExpr, body ...ast.Stmt) []ast.Stmt {
+	var (
+		file   = ast.NewIdent(varName)
+		errVar = ast.NewIdent("err")
+	)
+	if len(body) == 0 {
+		body = []ast.Stmt{Return(errVar)}
+	}
+	return []ast.Stmt{
+		Assign(VarNames{file, errVar}, Definition, openCall),
+		If(NotNil(errVar), body...),
+		DeferCall(InlineFunc(Selector(file, "Close"))),
+	}
+}
+
 func MakeTypeSwitch(assign ast.Stmt, cases ...SwitchCase) ast.Stmt {
 	return &ast.TypeSwitchStmt{
 		Assign: assign,
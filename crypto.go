@@ -0,0 +1,70 @@
+package asthlp
+
+import "go/ast"
+
+var (
+	// Base64StdEncoding represents the `base64.StdEncoding` encoding
+	Base64StdEncoding = SimpleSelector("base64", "StdEncoding")
+	// Base64URLEncoding represents the `base64.URLEncoding` encoding
+	Base64URLEncoding = SimpleSelector("base64", "URLEncoding")
+	// Base64RawURLEncoding represents the `base64.RawURLEncoding` encoding
+	Base64RawURLEncoding = SimpleSelector("base64", "RawURLEncoding")
+
+	// Sha256Sum256Fn is a construction of the `sha256.Sum256` function
+	Sha256Sum256Fn = makeFunc(SimpleSelector("sha256", "Sum256"), 1, false)
+	// Sha256NewFn is a construction of the `sha256.New` function
+	Sha256NewFn = makeFunc(SimpleSelector("sha256", "New"), 0, false)
+	// HmacNewFn is a construction of the `hmac.New` function
+	HmacNewFn = makeFunc(SimpleSelector("hmac", "New"), 2, false)
+	// HmacEqualFn is a construction of the `hmac.Equal` function
+	HmacEqualFn = makeFunc(SimpleSelector("hmac", "Equal"), 2, false)
+	// HexEncodeToStringFn is a construction of the `hex.EncodeToString` function
+	HexEncodeToStringFn = makeFunc(SimpleSelector("hex", "EncodeToString"), 1, false)
+	// HexDecodeStringFn is a construction of the `hex.DecodeString` function
+	HexDecodeStringFn = makeFunc(SimpleSelector("hex", "DecodeString"), 1, false)
+)
+
+// Base64EncodeToStringFn is a construction of the `<enc>.EncodeToString` method e.g. base64.StdEncoding.EncodeToString
+func Base64EncodeToStringFn(enc ast.Expr) CallFunctionDescriber {
+	return MethodOf(enc, "EncodeToString", 1, false)
+}
+
+// Base64DecodeStringFn is a construction of the `<enc>.DecodeString` method e.g. base64.StdEncoding.DecodeString
+func Base64DecodeStringFn(enc ast.Expr) CallFunctionDescriber {
+	return MethodOf(enc, "DecodeString", 1, false)
+}
+
+// HashWriteFn is a construction of the `<h>.Write` method of hash.Hash
+func HashWriteFn(h ast.Expr) CallFunctionDescriber {
+	return MethodOf(h, "Write", 1, false)
+}
+
+// HashSumFn is a construction of the `<h>.Sum` method of hash.Hash
+func HashSumFn(h ast.Expr) CallFunctionDescriber {
+	return MethodOf(h, "Sum", 1, false)
+}
+
+// MakeHashToHex creates the statements that hash the string fields with sha256 into the hex string variable,
+// nil values will be excluded from fields
+//
+//	h := sha256.New()
+//	h.Write([]byte(<field1>))
+//	h.Write([]byte(<field2>))
+//	<varName> := hex.EncodeToString(h.Sum(nil))
+func MakeHashToHex(varName string, fields ...ast.Expr) []ast.Stmt {
+	var (
+		h     = ast.NewIdent("h")
+		stmts = make([]ast.Stmt, 0, len(fields)+2)
+	)
+	stmts = append(stmts, Assign(VarNames{h}, Definition, Call(Sha256NewFn)))
+	for _, field := range fields {
+		if field != nil {
+			stmts = append(stmts, CallStmt(Call(HashWriteFn(h), ExpressionTypeConvert(field, ArrayType(Byte)))))
+		}
+	}
+	return append(stmts, Assign(
+		MakeVarNames(varName),
+		Definition,
+		Call(HexEncodeToStringFn, Call(HashSumFn(h), Nil)),
+	))
+}
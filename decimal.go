@@ -0,0 +1,54 @@
+package asthlp
+
+import "go/ast"
+
+var (
+	// DecimalDecimal represents the `decimal.Decimal` data type of github.com/shopspring/decimal
+	DecimalDecimal = SimpleSelector("decimal", "Decimal")
+	// DecimalZero represents the `decimal.Zero` value
+	DecimalZero = SimpleSelector("decimal", "Zero")
+
+	// DecimalNewFromIntFn is a construction of the `decimal.NewFromInt` function
+	DecimalNewFromIntFn = makeFunc(SimpleSelector("decimal", "NewFromInt"), 1, false)
+	// DecimalNewFromFloatFn is a construction of the `decimal.NewFromFloat` function
+	DecimalNewFromFloatFn = makeFunc(SimpleSelector("decimal", "NewFromFloat"), 1, false)
+	// DecimalNewFromStringFn is a construction of the `decimal.NewFromString` function
+	DecimalNewFromStringFn = makeFunc(SimpleSelector("decimal", "NewFromString"), 1, false)
+	// DecimalRequireFromStringFn is a construction of the `decimal.RequireFromString` function
+	DecimalRequireFromStringFn = makeFunc(SimpleSelector("decimal", "RequireFromString"), 1, false)
+)
+
+// DecimalAddFn is a construction of the `<d>.Add` method of decimal.Decimal
+func DecimalAddFn(d ast.Expr) CallFunctionDescriber {
+	return MethodOf(d, "Add", 1, false)
+}
+
+// DecimalSubFn is a construction of the `<d>.Sub` method of decimal.Decimal
+func DecimalSubFn(d ast.Expr) CallFunctionDescriber {
+	return MethodOf(d, "Sub", 1, false)
+}
+
+// DecimalMulFn is a construction of the `<d>.Mul` method of decimal.Decimal
+func DecimalMulFn(d ast.Expr) CallFunctionDescriber {
+	return MethodOf(d, "Mul", 1, false)
+}
+
+// DecimalDivFn is a construction of the `<d>.Div` method of decimal.Decimal
+func DecimalDivFn(d ast.Expr) CallFunctionDescriber {
+	return MethodOf(d, "Div", 1, false)
+}
+
+// DecimalCmpFn is a construction of the `<d>.Cmp` method of decimal.Decimal
+func DecimalCmpFn(d ast.Expr) CallFunctionDescriber {
+	return MethodOf(d, "Cmp", 1, false)
+}
+
+// DecimalEqualFn is a construction of the `<d>.Equal` method of decimal.Decimal
+func DecimalEqualFn(d ast.Expr) CallFunctionDescriber {
+	return MethodOf(d, "Equal", 1, false)
+}
+
+// DecimalStringFn is a construction of the `<d>.String` method of decimal.Decimal
+func DecimalStringFn(d ast.Expr) CallFunctionDescriber {
+	return MethodOf(d, "String", 0, false)
+}
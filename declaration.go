@@ -77,7 +77,7 @@ func (f *funcDecl) Decl() ast.Decl {
 		recv = &ast.FieldList{List: []*ast.Field{f.recv}}
 	}
 	return &ast.FuncDecl{
-		Doc:  &ast.CommentGroup{List: f.comm},
+		Doc:  commentGroup(f.comm),
 		Recv: recv,
 		Name: f.name,
 		Type: &ast.FuncType{
@@ -170,12 +170,8 @@ func (v *varDecl) AppendSpec(spec ...ast.Spec) VarDecl {
 }
 
 func (v *varDecl) Decl() ast.Decl {
-	var comm *ast.CommentGroup
-	if len(v.comm) > 0 {
-		comm = &ast.CommentGroup{List: v.comm}
-	}
 	return &ast.GenDecl{
-		Doc:   comm,
+		Doc:   commentGroup(v.comm),
 		Tok:   token.VAR,
 		Specs: v.spec,
 	}
@@ -184,3 +180,85 @@ func (v *varDecl) Decl() ast.Decl {
 func (v *varDecl) Stmt() ast.Stmt {
 	return &ast.DeclStmt{Decl: v.Decl()}
 }
+
+type (
+	fileDecl struct {
+		name *ast.Ident
+		comm []*ast.Comment
+		decl []ast.Decl
+		bfor []DeclHook
+		aftr []DeclObserver
+	}
+	FileDecl interface {
+		Comments(comments ...string) FileDecl
+		BeforeDecl(hooks ...DeclHook) FileDecl
+		AfterDecl(observers ...DeclObserver) FileDecl
+		AppendDecl(decl ...ast.Decl) FileDecl
+		File() *ast.File
+	}
+	// DeclHook receives the declaration before it is appended to the file and returns the declaration to append instead.
+	// Returning nil excludes the declaration from the file
+	DeclHook func(ast.Decl) ast.Decl
+	// DeclObserver receives the declaration after it is appended to the file
+	DeclObserver func(ast.Decl)
+)
+
+// DeclareFile creates a builder of the file with the package name.
+// Hooks registered with BeforeDecl and AfterDecl are applied to each declaration appended after the registration
+func DeclareFile(packageName string) FileDecl {
+	return &fileDecl{
+		name: ast.NewIdent(packageName),
+	}
+}
+
+func (f *fileDecl) Comments(comments ...string) FileDecl {
+	for _, comment := range comments {
+		f.comm = append(f.comm, &ast.Comment{Text: comment})
+	}
+	return f
+}
+
+func (f *fileDecl) BeforeDecl(hooks ...DeclHook) FileDecl {
+	f.bfor = append(f.bfor, hooks...)
+	return f
+}
+
+func (f *fileDecl) AfterDecl(observers ...DeclObserver) FileDecl {
+	f.aftr = append(f.aftr, observers...)
+	return f
+}
+
+func (f *fileDecl) AppendDecl(decl ...ast.Decl) FileDecl {
+	for _, d := range decl {
+		for _, hook := range f.bfor {
+			if d == nil {
+				break
+			}
+			d = hook(d)
+		}
+		if d == nil {
+			continue
+		}
+		f.decl = append(f.decl, d)
+		for _, observer := range f.aftr {
+			observer(d)
+		}
+	}
+	return f
+}
+
+func (f *fileDecl) File() *ast.File {
+	return &ast.File{
+		Doc:   commentGroup(f.comm),
+		Name:  f.name,
+		Decls: f.decl,
+	}
+}
+
+// commentGroup wraps the comments in the ast.CommentGroup structure. Returns nil if there are no comments
+func commentGroup(comments []*ast.Comment) *ast.CommentGroup {
+	if len(comments) == 0 {
+		return nil
+	}
+	return &ast.CommentGroup{List: comments}
+}
@@ -0,0 +1,171 @@
+package asthlp
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/iv-menshenin/go-ast/explorer"
+)
+
+// renderFile prints the file with go/printer and formats the result the way generated files are usually written
+func renderFile(t *testing.T, file *ast.File) string {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, token.NewFileSet(), file); err != nil {
+		t.Fatalf("cannot print the file: %v", err)
+	}
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		t.Fatalf("cannot format the file: %v\n%s", err, buf.String())
+	}
+	return string(formatted)
+}
+
+func assertRendered(t *testing.T, file *ast.File, expected string) {
+	t.Helper()
+	if got := renderFile(t, file); got != expected {
+		t.Errorf("unexpected output\nexpected:\n%s\ngot:\n%s", expected, got)
+	}
+}
+
+// importDecl explores the declarations and declares the imports of the packages they use
+func importDecl(d *explorer.Discoverer, decls ...ast.Decl) *ast.GenDecl {
+	for _, decl := range decls {
+		d.Explore(decl)
+	}
+	return &ast.GenDecl{Tok: token.IMPORT, Lparen: 1, Specs: d.ImportSpec()}
+}
+
+// importPaths returns the paths of the packages imported by the declaration
+func importPaths(t *testing.T, decl *ast.GenDecl) []string {
+	t.Helper()
+	var paths = make([]string, 0, len(decl.Specs))
+	for _, spec := range decl.Specs {
+		var fields = strings.Fields(spec.(*ast.ImportSpec).Path.Value)
+		path, err := strconv.Unquote(fields[len(fields)-1])
+		if err != nil {
+			t.Fatalf("cannot unquote the import path: %v", err)
+		}
+		paths = append(paths, path)
+	}
+	return paths
+}
+
+// stubImporter imports the packages from the stub sources keyed by the import path,
+// the other packages are imported with the default importer
+type stubImporter struct {
+	fset     *token.FileSet
+	stubs    map[string]string
+	std      types.Importer
+	packages map[string]*types.Package
+}
+
+func newStubImporter(stubs map[string]string) *stubImporter {
+	return &stubImporter{
+		fset:     token.NewFileSet(),
+		stubs:    stubs,
+		std:      importer.Default(),
+		packages: make(map[string]*types.Package),
+	}
+}
+
+func (i *stubImporter) Import(path string) (*types.Package, error) {
+	if pkg, ok := i.packages[path]; ok {
+		return pkg, nil
+	}
+	src, ok := i.stubs[path]
+	if !ok {
+		return i.std.Import(path)
+	}
+	file, err := parser.ParseFile(i.fset, path+".go", src, 0)
+	if err != nil {
+		return nil, err
+	}
+	pkg, err := (&types.Config{Importer: i}).Check(path, i.fset, []*ast.File{file}, nil)
+	if err != nil {
+		return nil, err
+	}
+	i.packages[path] = pkg
+	return pkg, nil
+}
+
+// typeCheck renders the declarations with the imports found by the discoverer and type-checks them
+// along with the support source of the same package declaring what the declarations refer to
+func typeCheck(t *testing.T, d *explorer.Discoverer, stubs map[string]string, support string, decls ...ast.Decl) {
+	t.Helper()
+	var (
+		imports = importDecl(d, decls...)
+		src     = renderFile(t, DeclareFile("p").AppendDecl(append([]ast.Decl{imports}, decls...)...).File())
+		fset    = token.NewFileSet()
+		files   []*ast.File
+	)
+	for name, content := range map[string]string{"generated.go": src, "support.go": support} {
+		file, err := parser.ParseFile(fset, name, content, 0)
+		if err != nil {
+			t.Fatalf("cannot parse %s: %v\n%s", name, err, content)
+		}
+		files = append(files, file)
+	}
+	var conf = types.Config{Importer: newStubImporter(stubs)}
+	if _, err := conf.Check("p", fset, files, nil); err != nil {
+		t.Errorf("the generated code does not compile: %v\n%s", err, src)
+	}
+}
+
+func TestFuncDecl_Decl(t *testing.T) {
+	t.Run("without_comments", func(t *testing.T) {
+		decl := DeclareFunction(ast.NewIdent("f")).Decl()
+		if doc := decl.(*ast.FuncDecl).Doc; doc != nil {
+			t.Errorf("expected no doc, got %d comments", len(doc.List))
+		}
+		assertRendered(t, DeclareFile("p").AppendDecl(decl).File(), "package p\n\nfunc f() {\n}\n")
+	})
+	t.Run("with_comments", func(t *testing.T) {
+		decl := DeclareFunction(ast.NewIdent("f")).
+			Comments("// f does nothing").
+			Results(Field("", nil, ErrorType)).
+			AppendStmt(Return(Nil)).
+			Decl()
+		assertRendered(t, DeclareFile("p").AppendDecl(decl).File(), "package p\n\n// f does nothing\nfunc f() error {\n\treturn nil\n}\n")
+	})
+}
+
+func TestVarDecl_Decl(t *testing.T) {
+	t.Run("without_comments", func(t *testing.T) {
+		decl := DeclareVariable().AppendSpec(VariableType("x", Int)).Decl()
+		if doc := decl.(*ast.GenDecl).Doc; doc != nil {
+			t.Errorf("expected no doc, got %d comments", len(doc.List))
+		}
+		assertRendered(t, DeclareFile("p").AppendDecl(decl).File(), "package p\n\nvar x int\n")
+	})
+	t.Run("with_comments", func(t *testing.T) {
+		decl := DeclareVariable().Comments("// x is zero").AppendSpec(VariableType("x", Int)).Decl()
+		assertRendered(t, DeclareFile("p").AppendDecl(decl).File(), "package p\n\n// x is zero\nvar x int\n")
+	})
+}
+
+func TestFileDecl_File(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		file := DeclareFile("p").File()
+		if file.Doc != nil {
+			t.Errorf("expected no doc, got %d comments", len(file.Doc.List))
+		}
+		assertRendered(t, file, "package p\n")
+	})
+	t.Run("with_decls", func(t *testing.T) {
+		file := DeclareFile("p").AppendDecl(
+			DeclareVariable().AppendSpec(VariableType("x", Int)).Decl(),
+			DeclareFunction(ast.NewIdent("f")).Decl(),
+		).File()
+		assertRendered(t, file, "package p\n\nvar x int\n\nfunc f() {\n}\n")
+	})
+}
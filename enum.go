@@ -0,0 +1,219 @@
+package asthlp
+
+import (
+	"go/ast"
+	"go/token"
+)
+
+type (
+	// EnumValue describes the constant declared with MakeEnum, e.g. the row of the lookup table
+	EnumValue struct {
+		// Name is converted to CamelCase and prefixed with the name of the type, it is also returned by String
+		Name  string
+		Value Expression
+	}
+)
+
+// MakeEnum declares the type with the base type, the constants of the values and the methods
+// String, Valid, Scan, Value, MarshalJSON and UnmarshalJSON, the base type is String or one of the integer types.
+// Scan, Value and the JSON methods refuse the values not declared. At least one value is required
+//
+//	type <name> string
+//
+//	const (
+//	    <name>Active  <name> = "active"
+//	    <name>Blocked <name> = "blocked"
+//	)
+//
+//	func (e <name>) Valid() bool {
+//	    switch e {
+//	    case <name>Active, <name>Blocked:
+//	        return true
+//	    }
+//	    return false
+//	}
+func MakeEnum(name string, base ast.Expr, values ...EnumValue) []ast.Decl {
+	if len(values) == 0 {
+		panic("enum " + name + " must have at least one value")
+	}
+	var (
+		typeName = ast.NewIdent(name)
+		consts   = make([]ast.Spec, 0, len(values))
+		idents   = make([]ast.Expr, 0, len(values))
+	)
+	for _, value := range values {
+		spec := &ast.ValueSpec{
+			Names:  []*ast.Ident{ast.NewIdent(name + camelCase(value.Name))},
+			Type:   typeName,
+			Values: []ast.Expr{value.Value.Expr()},
+		}
+		consts = append(consts, spec)
+		idents = append(idents, spec.Names[0])
+	}
+	return []ast.Decl{
+		&ast.GenDecl{Tok: token.TYPE, Specs: []ast.Spec{TypeSpec(name, base)}},
+		&ast.GenDecl{Tok: token.CONST, Lparen: 1, Specs: consts},
+		makeEnumString(typeName, base, idents, values),
+		makeEnumValid(typeName, idents),
+		makeEnumScan(typeName, base),
+		makeEnumValue(typeName, base),
+		makeEnumMarshalJSON(typeName, base),
+		makeEnumUnmarshalJSON(typeName, base),
+	}
+}
+
+// makeEnumString returns the name of the value
+//
+//	func (e <name>) String() string {
+//	    switch e {
+//	    case <name>Active:
+//	        return "active"
+//	    }
+//	    return fmt.Sprintf("<name>(%v)", string(e))
+//	}
+func makeEnumString(typeName *ast.Ident, base ast.Expr, idents []ast.Expr, values []EnumValue) ast.Decl {
+	var (
+		e     = ast.NewIdent("e")
+		cases = make([]SwitchCase, 0, len(values))
+	)
+	for i, value := range values {
+		cases = append(cases, MakeSwitchCase(idents[i]).Body(Return(StringConstant(value.Name).Expr())))
+	}
+	return DeclareFunction(ast.NewIdent("String")).
+		Receiver(Field(e.Name, nil, typeName)).
+		Results(Field("", nil, String)).
+		AppendStmt(
+			MakeSwitch(nil, e, cases...),
+			Return(Call(FmtSprintfFn, StringConstant(typeName.Name+"(%v)").Expr(), ExpressionTypeConvert(e, base))),
+		).
+		Decl()
+}
+
+func makeEnumValid(typeName *ast.Ident, idents []ast.Expr) ast.Decl {
+	var e = ast.NewIdent("e")
+	return DeclareFunction(ast.NewIdent("Valid")).
+		Receiver(Field(e.Name, nil, typeName)).
+		Results(Field("", nil, Bool)).
+		AppendStmt(
+			MakeSwitch(nil, e, MakeSwitchCase(idents...).Body(Return(True))),
+			Return(False),
+		).
+		Decl()
+}
+
+// makeEnumScan accepts strings and bytes for the string base type and int64 for the integer ones
+//
+//	func (e *<name>) Scan(src interface{}) error {
+//	    switch v := src.(type) {
+//	    case string:
+//	        *e = <name>(v)
+//	    case []byte:
+//	        *e = <name>(v)
+//	    default:
+//	        return fmt.Errorf("cannot scan %T into <name>", src)
+//	    }
+//	    if !e.Valid() {
+//	        return fmt.Errorf("invalid <name> value %v", *e)
+//	    }
+//	    return nil
+//	}
+func makeEnumScan(typeName *ast.Ident, base ast.Expr) ast.Decl {
+	var (
+		e     = ast.NewIdent("e")
+		v     = ast.NewIdent("v")
+		src   = ast.NewIdent("src")
+		set   = Assign(VarNames{Star(e)}, Assignment, ExpressionTypeConvert(v, typeName))
+		cases = []SwitchCase{MakeSwitchCase(Int64).Body(set)}
+	)
+	if isStringType(base) {
+		cases = []SwitchCase{
+			MakeSwitchCase(String).Body(set),
+			MakeSwitchCase(ArrayType(Byte)).Body(set),
+		}
+	}
+	cases = append(cases, MakeSwitchCase().Body(
+		Return(Call(FmtErrorfFn, StringConstant("cannot scan %T into "+typeName.Name).Expr(), src)),
+	))
+	return DeclareFunction(ast.NewIdent("Scan")).
+		Receiver(Field(e.Name, nil, Star(typeName))).
+		Params(Field(src.Name, nil, EmptyInterface)).
+		Results(Field("", nil, ErrorType)).
+		AppendStmt(
+			MakeTypeSwitch(Assign(VarNames{v}, Definition, ExpressionTypeAssert(src, nil)), cases...),
+			makeEnumInvalidCheck(typeName, e, Star(e)),
+			Return(Nil),
+		).
+		Decl()
+}
+
+func makeEnumValue(typeName *ast.Ident, base ast.Expr) ast.Decl {
+	var (
+		e         = ast.NewIdent("e")
+		driverVal = Int64
+	)
+	if isStringType(base) {
+		driverVal = String
+	}
+	return DeclareFunction(ast.NewIdent("Value")).
+		Receiver(Field(e.Name, nil, typeName)).
+		Results(Field("", nil, DriverValue), Field("", nil, ErrorType)).
+		AppendStmt(
+			makeEnumInvalidCheck(typeName, e, e, Nil),
+			Return(ExpressionTypeConvert(e, driverVal), Nil),
+		).
+		Decl()
+}
+
+func makeEnumMarshalJSON(typeName *ast.Ident, base ast.Expr) ast.Decl {
+	var e = ast.NewIdent("e")
+	return DeclareFunction(ast.NewIdent("MarshalJSON")).
+		Receiver(Field(e.Name, nil, typeName)).
+		Results(Field("", nil, ArrayType(Byte)), Field("", nil, ErrorType)).
+		AppendStmt(
+			makeEnumInvalidCheck(typeName, e, e, Nil),
+			Return(Call(JsonMarshal, ExpressionTypeConvert(e, base))),
+		).
+		Decl()
+}
+
+func makeEnumUnmarshalJSON(typeName *ast.Ident, base ast.Expr) ast.Decl {
+	var (
+		e      = ast.NewIdent("e")
+		v      = ast.NewIdent("v")
+		data   = ast.NewIdent("data")
+		errVar = ast.NewIdent("err")
+	)
+	return DeclareFunction(ast.NewIdent("UnmarshalJSON")).
+		Receiver(Field(e.Name, nil, Star(typeName))).
+		Params(Field(data.Name, nil, ArrayType(Byte))).
+		Results(Field("", nil, ErrorType)).
+		AppendStmt(
+			Var(VariableType(v.Name, base)),
+			IfInit(
+				Assign(VarNames{errVar}, Definition, Call(JsonUnmarshal, data, Ref(v))),
+				NotNil(errVar),
+				Return(errVar),
+			),
+			Assign(VarNames{Star(e)}, Assignment, ExpressionTypeConvert(v, typeName)),
+			makeEnumInvalidCheck(typeName, e, Star(e)),
+			Return(Nil),
+		).
+		Decl()
+}
+
+// makeEnumInvalidCheck returns the error after the leading results if the value is not declared
+//
+//	if !<e>.Valid() {
+//	    return <results>, fmt.Errorf("invalid <name> value %v", <value>)
+//	}
+func makeEnumInvalidCheck(typeName *ast.Ident, e, value ast.Expr, results ...ast.Expr) ast.Stmt {
+	return If(
+		Not(Call(InlineFunc(Selector(e, "Valid")))),
+		Return(append(results, Call(FmtErrorfFn, StringConstant("invalid "+typeName.Name+" value %v").Expr(), value))...),
+	)
+}
+
+func isStringType(t ast.Expr) bool {
+	ident, ok := t.(*ast.Ident)
+	return ok && ident.Name == String.Name
+}
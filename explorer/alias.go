@@ -0,0 +1,179 @@
+package explorer
+
+import (
+	"go/ast"
+	"reflect"
+	"sort"
+	"strconv"
+)
+
+// Rename changes the alias of the package and replaces the package identifier of the selectors explored so far.
+// The explored nodes are changed in place, but the selectors and the nodes containing them are replaced with copies,
+// so expressions shared with other files like asthlp.UUID keep their package identifiers
+func (i *Discoverer) Rename(path, alias string) {
+	for used := range i.imports {
+		if used.Package.Path == path {
+			i.rename(used, alias)
+		}
+	}
+}
+
+func (i *Discoverer) rename(used UsedPackage, alias string) {
+	if used.Alias == alias {
+		return
+	}
+	var (
+		ident   = ast.NewIdent(alias)
+		renamed = UsedPackage{Package: used.Package, Alias: alias}
+		copies  = make(map[*ast.SelectorExpr]*ast.SelectorExpr)
+	)
+	for _, sel := range i.imports[used] {
+		if _, ok := copies[sel]; !ok {
+			copies[sel] = &ast.SelectorExpr{X: ident, Sel: sel.Sel}
+			i.imports[renamed] = append(i.imports[renamed], copies[sel])
+		}
+	}
+	delete(i.imports, used)
+	for _, root := range i.roots {
+		replaceSelectors(root, copies)
+	}
+	i.bindings[ident] = used.Package
+}
+
+// resolveConflicts renames the packages that share the alias with another package. The package the alias is resolved to
+// keeps it, the others get the numbered aliases, e.g. uuid2. Numbered aliases are added to the lock if there is one
+func (i *Discoverer) resolveConflicts() {
+	var byAlias = make(map[string][]UsedPackage)
+	for used := range i.imports {
+		byAlias[used.Alias] = append(byAlias[used.Alias], used)
+	}
+	var aliases = make([]string, 0, len(byAlias))
+	for alias, used := range byAlias {
+		if len(used) > 1 {
+			aliases = append(aliases, alias)
+		}
+	}
+	sort.Strings(aliases)
+	for _, alias := range aliases {
+		var (
+			used     = byAlias[alias]
+			owner, _ = i.lookup(alias)
+		)
+		sort.Slice(used, func(i, j int) bool {
+			if (used[i].Package == owner) != (used[j].Package == owner) {
+				return used[i].Package == owner
+			}
+			if used[i].Package.Kind == used[j].Package.Kind {
+				return used[i].Package.Path < used[j].Package.Path
+			}
+			return used[i].Package.Kind < used[j].Package.Kind
+		})
+		for _, conflicted := range used[1:] {
+			numbered := i.freeAlias(alias, byAlias)
+			byAlias[numbered] = []UsedPackage{{Package: conflicted.Package, Alias: numbered}}
+			i.rename(conflicted, numbered)
+			if i.lock != nil {
+				i.lock.lock(numbered, conflicted.Package)
+			}
+		}
+	}
+}
+
+func (i *Discoverer) freeAlias(alias string, taken map[string][]UsedPackage) string {
+	for n := 2; ; n++ {
+		numbered := alias + strconv.Itoa(n)
+		if _, ok := taken[numbered]; !ok {
+			return numbered
+		}
+	}
+}
+
+var nodeType = reflect.TypeOf((*ast.Node)(nil)).Elem()
+
+// replaceSelectors replaces the selectors under the root with their copies. The root is changed in place,
+// the nodes between the root and the selectors are copied since they can be shared, e.g. `[]uuid.UUID` built once
+func replaceSelectors(root ast.Node, copies map[*ast.SelectorExpr]*ast.SelectorExpr) {
+	var v = reflect.ValueOf(root)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return
+	}
+	if fields, ok := replaceFields(v.Elem(), copies); ok {
+		v.Elem().Set(fields)
+	}
+}
+
+// replaceValue returns the copy of the value with the selectors replaced, false is returned if there is nothing to replace.
+// Only the values implementing ast.Node are walked, so the objects and the scopes are left as they are
+func replaceValue(v reflect.Value, copies map[*ast.SelectorExpr]*ast.SelectorExpr) (reflect.Value, bool) {
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			return v, false
+		}
+		elem, ok := replaceValue(v.Elem(), copies)
+		if !ok {
+			return v, false
+		}
+		var result = reflect.New(v.Type()).Elem()
+		result.Set(elem)
+		return result, true
+
+	case reflect.Ptr:
+		if v.IsNil() || !v.Type().Implements(nodeType) {
+			return v, false
+		}
+		if sel, ok := v.Interface().(*ast.SelectorExpr); ok {
+			if c, ok := copies[sel]; ok {
+				return reflect.ValueOf(c), true
+			}
+		}
+		fields, ok := replaceFields(v.Elem(), copies)
+		if !ok {
+			return v, false
+		}
+		var result = reflect.New(fields.Type())
+		result.Elem().Set(fields)
+		return result, true
+
+	case reflect.Slice:
+		var result reflect.Value
+		for n := 0; n < v.Len(); n++ {
+			elem, ok := replaceValue(v.Index(n), copies)
+			if !ok {
+				continue
+			}
+			if !result.IsValid() {
+				result = reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+				reflect.Copy(result, v)
+			}
+			result.Index(n).Set(elem)
+		}
+		if !result.IsValid() {
+			return v, false
+		}
+		return result, true
+	}
+	return v, false
+}
+
+func replaceFields(v reflect.Value, copies map[*ast.SelectorExpr]*ast.SelectorExpr) (reflect.Value, bool) {
+	if v.Kind() != reflect.Struct {
+		return v, false
+	}
+	var result reflect.Value
+	for n := 0; n < v.NumField(); n++ {
+		field, ok := replaceValue(v.Field(n), copies)
+		if !ok {
+			continue
+		}
+		if !result.IsValid() {
+			result = reflect.New(v.Type()).Elem()
+			result.Set(v)
+		}
+		result.Field(n).Set(field)
+	}
+	if !result.IsValid() {
+		return v, false
+	}
+	return result, true
+}
@@ -10,7 +10,12 @@ import (
 
 type (
 	Discoverer struct {
-		imports map[string]UsedPackage
+		imports   map[UsedPackage][]*ast.SelectorExpr
+		usages    map[string]map[string]*Usage
+		positions map[ast.Node]bool
+		lock      *AliasLock
+		bindings  map[*ast.Ident]Package
+		roots     []ast.Node
 	}
 	UsedPackage struct {
 		Package Package
@@ -39,10 +44,15 @@ var (
 		"aes":       {Path: "crypto/aes", Kind: PkgKindSystem},
 		"des":       {Path: "crypto/des", Kind: PkgKindSystem},
 		"md5":       {Path: "crypto/md5", Kind: PkgKindSystem},
+		"hmac":      {Path: "crypto/hmac", Kind: PkgKindSystem},
 		"crand":     {Path: "crypto/rand", Kind: PkgKindSystem},
+		"sha256":    {Path: "crypto/sha256", Kind: PkgKindSystem},
 		"sha512":    {Path: "crypto/sha512", Kind: PkgKindSystem},
 		"x509":      {Path: "crypto/x509", Kind: PkgKindSystem},
 		"sql":       {Path: "database/sql", Kind: PkgKindSystem},
+		"driver":    {Path: "database/sql/driver", Kind: PkgKindSystem},
+		"base64":    {Path: "encoding/base64", Kind: PkgKindSystem},
+		"binary":    {Path: "encoding/binary", Kind: PkgKindSystem},
 		"hex":       {Path: "encoding/hex", Kind: PkgKindSystem},
 		"json":      {Path: "encoding/json", Kind: PkgKindSystem},
 		"xml":       {Path: "encoding/xml", Kind: PkgKindSystem},
@@ -54,6 +64,8 @@ var (
 		"png":       {Path: "image/png", Kind: PkgKindSystem},
 		"io":        {Path: "io", Kind: PkgKindSystem},
 		"log":       {Path: "log", Kind: PkgKindSystem},
+		"maps":      {Path: "maps", Kind: PkgKindSystem},
+		"slog":      {Path: "log/slog", Kind: PkgKindSystem},
 		"math":      {Path: "math", Kind: PkgKindSystem},
 		"big":       {Path: "math/big", Kind: PkgKindSystem},
 		"rand":      {Path: "math/rand", Kind: PkgKindSystem},
@@ -64,11 +76,15 @@ var (
 		"url":       {Path: "net/url", Kind: PkgKindSystem},
 		"os":        {Path: "os", Kind: PkgKindSystem},
 		"path":      {Path: "path", Kind: PkgKindSystem},
+		"filepath":  {Path: "path/filepath", Kind: PkgKindSystem},
 		"reflect":   {Path: "reflect", Kind: PkgKindSystem},
 		"regexp":    {Path: "regexp", Kind: PkgKindSystem},
+		"slices":    {Path: "slices", Kind: PkgKindSystem},
 		"sort":      {Path: "sort", Kind: PkgKindSystem},
 		"strconv":   {Path: "strconv", Kind: PkgKindSystem},
+		"strings":   {Path: "strings", Kind: PkgKindSystem},
 		"sync":      {Path: "sync", Kind: PkgKindSystem},
+		"testing":   {Path: "testing", Kind: PkgKindSystem},
 		"time":      {Path: "time", Kind: PkgKindSystem},
 		"unicode":   {Path: "unicode", Kind: PkgKindSystem},
 		"utf8":      {Path: "unicode/utf8", Kind: PkgKindSystem},
@@ -78,6 +94,13 @@ var (
 		"fastjson":  {Path: "github.com/valyala/fastjson", Kind: PkgKindExternal},
 		"router":    {Path: "github.com/fasthttp/router", Kind: PkgKindExternal},
 		"uuid":      {Path: "github.com/google/uuid", Kind: PkgKindExternal},
+		"pq":        {Path: "github.com/lib/pq", Kind: PkgKindExternal},
+		"decimal":   {Path: "github.com/shopspring/decimal", Kind: PkgKindExternal},
+		"assert":    {Path: "github.com/stretchr/testify/assert", Kind: PkgKindExternal},
+		"require":   {Path: "github.com/stretchr/testify/require", Kind: PkgKindExternal},
+		"cases":     {Path: "golang.org/x/text/cases", Kind: PkgKindExternal},
+		"bcrypt":    {Path: "golang.org/x/crypto/bcrypt", Kind: PkgKindExternal},
+		"language":  {Path: "golang.org/x/text/language", Kind: PkgKindExternal},
 	}
 )
 
@@ -85,42 +108,131 @@ func RegisterPackage(packName string, pkg Package) {
 	knownPackages[packName] = pkg
 }
 
+// KnownPackage returns the package registered with the name
+func KnownPackage(packName string) (Package, bool) {
+	pkg, ok := knownPackages[packName]
+	return pkg, ok
+}
+
+// KnownAlias returns the alias the package with the path is registered with,
+// the shortest alias is returned if there are several of them
+func KnownAlias(path string) (string, bool) {
+	var alias string
+	for name, pkg := range knownPackages {
+		if pkg.Path != path {
+			continue
+		}
+		if alias == "" || len(name) < len(alias) || (len(name) == len(alias) && name < alias) {
+			alias = name
+		}
+	}
+	return alias, alias != ""
+}
+
 func New() *Discoverer {
 	return &Discoverer{
-		imports: make(map[string]UsedPackage),
+		imports:   make(map[UsedPackage][]*ast.SelectorExpr),
+		usages:    make(map[string]map[string]*Usage),
+		positions: make(map[ast.Node]bool),
+		bindings:  make(map[*ast.Ident]Package),
+	}
+}
+
+// UseLock makes the Discoverer resolve aliases with the lock first, aliases resolved with the known packages are added to the lock
+func (i *Discoverer) UseLock(lock *AliasLock) {
+	i.lock = lock
+}
+
+// BindIdent attributes the package identifier of the expression (`x` of the `x.Sel` selector or the identifier itself)
+// to the package regardless of known packages, the name of the identifier becomes the import alias.
+// Note that expressions shared between usages like asthlp.UUID are bound at all of them
+//
+//	gen := asthlp.SimpleSelector("guuid", "New")
+//	discoverer.BindIdent(gen, explorer.Package{Path: "github.com/google/uuid", Kind: explorer.PkgKindExternal})
+func (i *Discoverer) BindIdent(expr ast.Expr, pkg Package) {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		i.bindings[e] = pkg
+	case *ast.SelectorExpr:
+		if x, ok := e.X.(*ast.Ident); ok {
+			i.bindings[x] = pkg
+		}
 	}
 }
 
 func (i *Discoverer) Explore(node ast.Node) {
+	i.roots = append(i.roots, node)
 	ast.Walk(i, node)
 }
 
 func (i *Discoverer) Visit(node ast.Node) (w ast.Visitor) {
-	sel, ok := node.(*ast.SelectorExpr)
-	if !ok {
-		return i
+	return i.visit(node, false)
+}
+
+func (i *Discoverer) visit(node ast.Node, typePos bool) ast.Visitor {
+	if node == nil {
+		return nil
 	}
+	if pos, ok := i.positions[node]; ok {
+		typePos = pos
+		delete(i.positions, node)
+	}
+	i.markPositions(node)
+	if sel, ok := node.(*ast.SelectorExpr); ok {
+		i.discover(sel, typePos)
+	}
+	if typePos {
+		return typeVisitor{d: i}
+	}
+	return i
+}
+
+func (i *Discoverer) discover(sel *ast.SelectorExpr, typePos bool) {
 	x, ok := sel.X.(*ast.Ident)
 	if !ok {
-		return i
+		return
+	}
+	pack, ok := i.bindings[x]
+	if !ok {
+		pack, ok = i.lookup(x.String())
 	}
-	pack, ok := knownPackages[x.String()]
 	if ok {
-		i.imports[pack.Path] = UsedPackage{
+		used := UsedPackage{
 			Package: pack,
 			Alias:   x.String(),
 		}
+		i.imports[used] = append(i.imports[used], sel)
+		i.use(pack.Path, sel.Sel.Name, typePos)
 	}
-	return i
 }
 
+func (i *Discoverer) lookup(alias string) (Package, bool) {
+	if i.lock == nil {
+		pack, ok := knownPackages[alias]
+		return pack, ok
+	}
+	if pack, ok := i.lock.Lookup(alias); ok {
+		return pack, true
+	}
+	pack, ok := knownPackages[alias]
+	if ok {
+		i.lock.lock(alias, pack)
+	}
+	return pack, ok
+}
+
+// ImportSpec resolves the alias conflicts (see Rename) and returns the specs of the used packages
 func (i *Discoverer) ImportSpec() []ast.Spec {
+	i.resolveConflicts()
 	var imports []UsedPackage
-	for _, pkg := range i.imports {
+	for pkg := range i.imports {
 		imports = append(imports, pkg)
 	}
 	sort.SliceStable(imports, func(i, j int) bool {
 		if imports[i].Package.Kind == imports[j].Package.Kind {
+			if imports[i].Package.Path == imports[j].Package.Path {
+				return imports[i].Alias < imports[j].Alias
+			}
 			return imports[i].Package.Path < imports[j].Package.Path
 		}
 		return imports[i].Package.Kind < imports[j].Package.Kind
@@ -0,0 +1,100 @@
+package explorer
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+type (
+	// AliasLock keeps the alias to package decisions made by the Discoverer, so that subsequent runs resolve
+	// the aliases the same way even if other packages were registered with RegisterPackage meanwhile
+	AliasLock struct {
+		aliases map[string]Package
+	}
+)
+
+var pkgKindNames = map[PkgKind]string{
+	PkgKindSystem:   "system",
+	PkgKindExternal: "external",
+	PkgKindInternal: "internal",
+}
+
+func NewAliasLock() *AliasLock {
+	return &AliasLock{
+		aliases: make(map[string]Package),
+	}
+}
+
+// ReadAliasLock reads the lock written by AliasLock.WriteTo, each line contains an alias, a package path and a package kind
+//
+//	uuid github.com/google/uuid external
+func ReadAliasLock(r io.Reader) (*AliasLock, error) {
+	var (
+		lock    = NewAliasLock()
+		scanner = bufio.NewScanner(r)
+		lineNum = 0
+	)
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("alias lock line %d: expected alias, path and kind, got %q", lineNum, line)
+		}
+		kind, ok := parsePkgKind(fields[2])
+		if !ok {
+			return nil, fmt.Errorf("alias lock line %d: unknown package kind %q", lineNum, fields[2])
+		}
+		lock.aliases[fields[0]] = Package{Path: fields[1], Kind: kind}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return lock, nil
+}
+
+// WriteTo writes the lock sorted by alias
+func (l *AliasLock) WriteTo(w io.Writer) (int64, error) {
+	var aliases = make([]string, 0, len(l.aliases))
+	for alias := range l.aliases {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+	var written int64
+	for _, alias := range aliases {
+		pkg := l.aliases[alias]
+		n, err := fmt.Fprintf(w, "%s %s %s\n", alias, pkg.Path, pkgKindNames[pkg.Kind])
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
+
+// Lookup returns the package locked with the alias
+func (l *AliasLock) Lookup(alias string) (Package, bool) {
+	pkg, ok := l.aliases[alias]
+	return pkg, ok
+}
+
+func (l *AliasLock) lock(alias string, pkg Package) {
+	if _, ok := l.aliases[alias]; !ok {
+		l.aliases[alias] = pkg
+	}
+}
+
+func parsePkgKind(s string) (PkgKind, bool) {
+	for kind, name := range pkgKindNames {
+		if name == s {
+			return kind, true
+		}
+	}
+	return 0, false
+}
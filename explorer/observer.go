@@ -0,0 +1,43 @@
+package explorer
+
+import "go/ast"
+
+type (
+	// ObserverDiscoverer explores declarations one by one as they are built, so there is no need to walk
+	// the whole tree at the end. Use Observe as the declaration observer of the file builder
+	//
+	//	discoverer := explorer.NewObserver()
+	//	file := asthlp.DeclareFile("pkg").AfterDecl(discoverer.Observe)
+	ObserverDiscoverer struct {
+		*Discoverer
+		observed map[ast.Decl]struct{}
+	}
+)
+
+func NewObserver() *ObserverDiscoverer {
+	return &ObserverDiscoverer{
+		Discoverer: New(),
+		observed:   make(map[ast.Decl]struct{}),
+	}
+}
+
+// Observe explores the declaration unless it has already been explored
+func (o *ObserverDiscoverer) Observe(decl ast.Decl) {
+	if _, ok := o.observed[decl]; ok {
+		return
+	}
+	o.observed[decl] = struct{}{}
+	o.Discoverer.Explore(decl)
+}
+
+// Explore explores the node, declarations of the file that have already been observed are skipped
+func (o *ObserverDiscoverer) Explore(node ast.Node) {
+	file, ok := node.(*ast.File)
+	if !ok {
+		o.Discoverer.Explore(node)
+		return
+	}
+	for _, decl := range file.Decls {
+		o.Observe(decl)
+	}
+}
@@ -0,0 +1,59 @@
+package explorer
+
+import (
+	"go/ast"
+	"strconv"
+	"sync"
+)
+
+type (
+	// PackageRefs creates the package identifiers by import path for the packages that are not known
+	// or are known under another path, e.g. crypto/rand while `rand` refers to math/rand.
+	// The identifiers are attributed to their packages with Bind, the known packages are left as they are
+	PackageRefs struct {
+		mux    sync.Mutex
+		idents map[string]*ast.Ident
+		bound  map[*ast.Ident]Package
+	}
+)
+
+func NewPackageRefs() *PackageRefs {
+	return &PackageRefs{
+		idents: make(map[string]*ast.Ident),
+		bound:  make(map[*ast.Ident]Package),
+	}
+}
+
+// Ident returns the identifier of the package, the same identifier is returned for the same path.
+// The alias of the known package with the path is used if there is one, otherwise the name is used unless it is known
+// as another package, in which case the name is numbered, e.g. rand2
+func (r *PackageRefs) Ident(pkg Package, name string) *ast.Ident {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	if ident, ok := r.idents[pkg.Path]; ok {
+		return ident
+	}
+	var alias, ok = KnownAlias(pkg.Path)
+	if !ok {
+		alias = name
+		for n := 2; ; n++ {
+			if known, ok := KnownPackage(alias); !ok || known.Path == pkg.Path {
+				break
+			}
+			alias = name + strconv.Itoa(n)
+		}
+	}
+	var ident = ast.NewIdent(alias)
+	r.idents[pkg.Path] = ident
+	r.bound[ident] = pkg
+	return ident
+}
+
+// Bind attributes the identifiers created so far to their packages in the discoverer (see Discoverer.BindIdent)
+func (r *PackageRefs) Bind(d *Discoverer) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	for ident, pkg := range r.bound {
+		d.BindIdent(ident, pkg)
+	}
+}
@@ -0,0 +1,96 @@
+package explorer
+
+import (
+	"go/ast"
+	"sort"
+)
+
+type (
+	// Usage describes the references to one identifier of the package, e.g. `UUID` from `uuid.UUID`
+	Usage struct {
+		Name string
+		// TypeRefs is the number of references in type position, e.g. `var id uuid.UUID`
+		TypeRefs int
+		// ValueRefs is the number of references in value position, e.g. `id := uuid.New()`
+		ValueRefs int
+	}
+	// typeVisitor walks the subtree that is known to be in type position
+	typeVisitor struct {
+		d *Discoverer
+	}
+)
+
+// TypeOnly returns true if the identifier is never referenced as a value
+func (u Usage) TypeOnly() bool {
+	return u.ValueRefs == 0
+}
+
+func (v typeVisitor) Visit(node ast.Node) ast.Visitor {
+	return v.d.visit(node, true)
+}
+
+// Usages returns the identifiers referenced from the package with the given path, sorted by name.
+// Type conversions like `uuid.UUID(b)` are counted as value references.
+func (i *Discoverer) Usages(path string) []Usage {
+	var result = make([]Usage, 0, len(i.usages[path]))
+	for _, usage := range i.usages[path] {
+		result = append(result, *usage)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+func (i *Discoverer) use(path, name string, typePos bool) {
+	idents, ok := i.usages[path]
+	if !ok {
+		idents = make(map[string]*Usage)
+		i.usages[path] = idents
+	}
+	usage, ok := idents[name]
+	if !ok {
+		usage = &Usage{Name: name}
+		idents[name] = usage
+	}
+	if typePos {
+		usage.TypeRefs++
+	} else {
+		usage.ValueRefs++
+	}
+}
+
+// markPositions remembers which children of the node are in type position and which are in value position,
+// all other children inherit the position of the node
+func (i *Discoverer) markPositions(node ast.Node) {
+	switch n := node.(type) {
+	case *ast.Field:
+		i.mark(n.Type, true)
+	case *ast.ValueSpec:
+		i.mark(n.Type, true)
+	case *ast.TypeSpec:
+		i.mark(n.Type, true)
+	case *ast.CompositeLit:
+		i.mark(n.Type, true)
+	case *ast.TypeAssertExpr:
+		i.mark(n.Type, true)
+	case *ast.ArrayType:
+		i.mark(n.Len, false)
+		i.mark(n.Elt, true)
+	case *ast.MapType:
+		i.mark(n.Key, true)
+		i.mark(n.Value, true)
+	case *ast.ChanType:
+		i.mark(n.Value, true)
+	case *ast.CallExpr:
+		if fn, ok := n.Fun.(*ast.Ident); ok && (fn.Name == "new" || fn.Name == "make") && len(n.Args) > 0 {
+			i.mark(n.Args[0], true)
+		}
+	}
+}
+
+func (i *Discoverer) mark(expr ast.Expr, typePos bool) {
+	if expr != nil {
+		i.positions[expr] = typePos
+	}
+}
@@ -308,28 +308,31 @@ func Great(left, right ast.Expr) ast.Expr {
 // Add represents an addition operation
 //
 //	<expr1> + <expr2> + <expr3>
+//
+// nil values will be excluded, returns nil if there is nothing to add
 func Add(exps ...ast.Expr) ast.Expr {
-	var acc ast.Expr = nil
-	for _, expr := range exps {
-		if acc == nil {
-			acc = expr
-		} else {
-			acc = Binary(acc, expr, token.ADD)
-		}
-	}
-	return acc
+	return arithmeticChain(token.ADD, exps)
 }
 
 // Sub represents a subtraction operation
 //
 //	<expr1> - <expr2> - <expr3>
+//
+// nil values will be excluded, returns nil if there is nothing to subtract
 func Sub(exps ...ast.Expr) ast.Expr {
+	return arithmeticChain(token.SUB, exps)
+}
+
+func arithmeticChain(tok token.Token, exps []ast.Expr) ast.Expr {
 	var acc ast.Expr = nil
 	for _, expr := range exps {
+		if expr == nil {
+			continue
+		}
 		if acc == nil {
 			acc = expr
 		} else {
-			acc = Binary(acc, expr, token.SUB)
+			acc = Binary(acc, expr, tok)
 		}
 	}
 	return acc
@@ -352,21 +355,29 @@ func IsNil(expr ast.Expr) ast.Expr {
 // And represents `&&` in comparison operation
 //
 //	<expr> && <expr> && <expr>
+//
+// nil values will be excluded, returns nil if all values are nil
 func And(left ast.Expr, expr ...ast.Expr) ast.Expr {
-	if len(expr) == 0 {
-		return left
-	}
-	return Binary(left, And(expr[0], expr[1:]...), token.LAND)
+	return logicalChain(token.LAND, ClearEmptyExpressions(left, expr...))
 }
 
 // Or represents `||` in comparison operation
 //
 //	<expr> || <expr> || <expr>
+//
+// nil values will be excluded, returns nil if all values are nil
 func Or(left ast.Expr, expr ...ast.Expr) ast.Expr {
-	if len(expr) == 0 {
-		return left
+	return logicalChain(token.LOR, ClearEmptyExpressions(left, expr...))
+}
+
+func logicalChain(tok token.Token, exps []ast.Expr) ast.Expr {
+	switch len(exps) {
+	case 0:
+		return nil
+	case 1:
+		return exps[0]
 	}
-	return Binary(left, And(expr[0], expr[1:]...), token.LOR)
+	return Binary(exps[0], logicalChain(tok, exps[1:]), tok)
 }
 
 // VariableTypeAssert represents variable type assertion expression
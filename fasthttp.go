@@ -0,0 +1,123 @@
+package asthlp
+
+import (
+	"go/ast"
+	"strings"
+)
+
+var (
+	// FasthttpRequestCtx represents the `fasthttp.RequestCtx` struct
+	FasthttpRequestCtx = SimpleSelector("fasthttp", "RequestCtx")
+	// RouterRouter represents the `router.Router` struct of github.com/fasthttp/router
+	RouterRouter = SimpleSelector("router", "Router")
+
+	// FasthttpStatusOK represents the `fasthttp.StatusOK` constant
+	FasthttpStatusOK = SimpleSelector("fasthttp", "StatusOK")
+	// FasthttpStatusBadRequest represents the `fasthttp.StatusBadRequest` constant
+	FasthttpStatusBadRequest = SimpleSelector("fasthttp", "StatusBadRequest")
+	// FasthttpStatusInternalServerError represents the `fasthttp.StatusInternalServerError` constant
+	FasthttpStatusInternalServerError = SimpleSelector("fasthttp", "StatusInternalServerError")
+
+	// RouterNewFn is a construction of the `router.New` function
+	RouterNewFn = makeFunc(SimpleSelector("router", "New"), 0, false)
+
+	// FasthttpSetStatusCodeFn is a construction of the `ctx.SetStatusCode` function of fasthttp.RequestCtx
+	FasthttpSetStatusCodeFn = makeFunc(SimpleSelector("ctx", "SetStatusCode"), 1, false)
+	// FasthttpSetContentTypeFn is a construction of the `ctx.SetContentType` function of fasthttp.RequestCtx
+	FasthttpSetContentTypeFn = makeFunc(SimpleSelector("ctx", "SetContentType"), 1, false)
+	// FasthttpSetBodyFn is a construction of the `ctx.SetBody` function of fasthttp.RequestCtx
+	FasthttpSetBodyFn = makeFunc(SimpleSelector("ctx", "SetBody"), 1, false)
+	// FasthttpWriteFn is a construction of the `ctx.Write` function of fasthttp.RequestCtx
+	FasthttpWriteFn = makeFunc(SimpleSelector("ctx", "Write"), 1, false)
+	// FasthttpErrorFn is a construction of the `ctx.Error` function of fasthttp.RequestCtx
+	FasthttpErrorFn = makeFunc(SimpleSelector("ctx", "Error"), 2, false)
+	// FasthttpPostBodyFn is a construction of the `ctx.PostBody` function of fasthttp.RequestCtx
+	FasthttpPostBodyFn = makeFunc(SimpleSelector("ctx", "PostBody"), 0, false)
+	// FasthttpUserValueFn is a construction of the `ctx.UserValue` function of fasthttp.RequestCtx, returns path parameters
+	FasthttpUserValueFn = makeFunc(SimpleSelector("ctx", "UserValue"), 1, false)
+	// FasthttpQueryArgsFn is a construction of the `ctx.QueryArgs` function of fasthttp.RequestCtx
+	FasthttpQueryArgsFn = makeFunc(SimpleSelector("ctx", "QueryArgs"), 0, false)
+)
+
+// FasthttpSetStatusCodeMethod is a construction of the `<ctx>.SetStatusCode` method of fasthttp.RequestCtx called on the given receiver
+func FasthttpSetStatusCodeMethod(ctx ast.Expr) CallFunctionDescriber {
+	return MethodOf(ctx, "SetStatusCode", 1, false)
+}
+
+// FasthttpSetContentTypeMethod is a construction of the `<ctx>.SetContentType` method of fasthttp.RequestCtx called on the given receiver
+func FasthttpSetContentTypeMethod(ctx ast.Expr) CallFunctionDescriber {
+	return MethodOf(ctx, "SetContentType", 1, false)
+}
+
+// FasthttpSetBodyMethod is a construction of the `<ctx>.SetBody` method of fasthttp.RequestCtx called on the given receiver
+func FasthttpSetBodyMethod(ctx ast.Expr) CallFunctionDescriber {
+	return MethodOf(ctx, "SetBody", 1, false)
+}
+
+// FasthttpWriteMethod is a construction of the `<ctx>.Write` method of fasthttp.RequestCtx called on the given receiver
+func FasthttpWriteMethod(ctx ast.Expr) CallFunctionDescriber {
+	return MethodOf(ctx, "Write", 1, false)
+}
+
+// FasthttpErrorMethod is a construction of the `<ctx>.Error` method of fasthttp.RequestCtx called on the given receiver
+func FasthttpErrorMethod(ctx ast.Expr) CallFunctionDescriber {
+	return MethodOf(ctx, "Error", 2, false)
+}
+
+// FasthttpPostBodyMethod is a construction of the `<ctx>.PostBody` method of fasthttp.RequestCtx called on the given receiver
+func FasthttpPostBodyMethod(ctx ast.Expr) CallFunctionDescriber {
+	return MethodOf(ctx, "PostBody", 0, false)
+}
+
+// FasthttpUserValueMethod is a construction of the `<ctx>.UserValue` method of fasthttp.RequestCtx called on the given receiver
+func FasthttpUserValueMethod(ctx ast.Expr) CallFunctionDescriber {
+	return MethodOf(ctx, "UserValue", 1, false)
+}
+
+// FasthttpQueryArgsMethod is a construction of the `<ctx>.QueryArgs` method of fasthttp.RequestCtx called on the given receiver
+func FasthttpQueryArgsMethod(ctx ast.Expr) CallFunctionDescriber {
+	return MethodOf(ctx, "QueryArgs", 0, false)
+}
+
+type (
+	// Route describes the endpoint registered with MakeRouteRegistration
+	Route struct {
+		// Method is the HTTP method e.g. GET
+		Method string
+		// Path is the path with parameters in braces e.g. /users/{id}
+		Path string
+		// Handler is the name of the handler method
+		Handler string
+	}
+)
+
+// RouterHandleFn is a construction of the `<r>.<METHOD>` method of router.Router e.g. r.GET
+func RouterHandleFn(r ast.Expr, method string) CallFunctionDescriber {
+	return MethodOf(r, strings.ToUpper(method), 2, false)
+}
+
+// MakeRouteRegistration declares the function registering the handler methods with the router
+//
+//	func <name>(r *router.Router, h <handlersType>) {
+//	    r.GET("/users/{id}", h.GetUser)
+//	    r.POST("/users", h.CreateUser)
+//	}
+func MakeRouteRegistration(name string, handlersType ast.Expr, routes ...Route) ast.Decl {
+	var (
+		r = ast.NewIdent("r")
+		h = ast.NewIdent("h")
+	)
+	var fn = DeclareFunction(ast.NewIdent(name)).
+		Params(
+			Field(r.Name, nil, Star(RouterRouter)),
+			Field(h.Name, nil, handlersType),
+		)
+	for _, route := range routes {
+		fn.AppendStmt(CallStmt(Call(
+			RouterHandleFn(r, route.Method),
+			StringConstant(route.Path).Expr(),
+			Selector(h, route.Handler),
+		)))
+	}
+	return fn.Decl()
+}
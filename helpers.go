@@ -36,6 +36,14 @@ func IfKeyVal(key string, value ast.Expr) ast.Expr {
 	}
 }
 
+// receiverName returns the name of the receiver the method refers to, the receiver must be named
+func receiverName(recv *ast.Field, method string) *ast.Ident {
+	if recv == nil || len(recv.Names) == 0 || recv.Names[0].Name == "" || recv.Names[0].Name == "_" {
+		panic("the receiver of " + method + " must be named")
+	}
+	return recv.Names[0]
+}
+
 func safeExpr(expression Expression) ast.Expr {
 	if expression == nil {
 		return nil
@@ -0,0 +1,242 @@
+package asthlp
+
+import "go/ast"
+
+var (
+	// HttpResponseWriter represents the `http.ResponseWriter` interface
+	HttpResponseWriter = SimpleSelector("http", "ResponseWriter")
+	// HttpRequest represents the `http.Request` struct
+	HttpRequest = SimpleSelector("http", "Request")
+	// HttpResponse represents the `http.Response` struct
+	HttpResponse = SimpleSelector("http", "Response")
+	// HttpClient represents the `http.Client` struct
+	HttpClient = SimpleSelector("http", "Client")
+	// HttpServeMux represents the `http.ServeMux` struct
+	HttpServeMux = SimpleSelector("http", "ServeMux")
+	// UrlValues represents the `url.Values` type
+	UrlValues = SimpleSelector("url", "Values")
+
+	// HttpStatusOK represents the `http.StatusOK` constant
+	HttpStatusOK = SimpleSelector("http", "StatusOK")
+	// HttpStatusNoContent represents the `http.StatusNoContent` constant
+	HttpStatusNoContent = SimpleSelector("http", "StatusNoContent")
+	// HttpStatusBadRequest represents the `http.StatusBadRequest` constant
+	HttpStatusBadRequest = SimpleSelector("http", "StatusBadRequest")
+	// HttpStatusNotFound represents the `http.StatusNotFound` constant
+	HttpStatusNotFound = SimpleSelector("http", "StatusNotFound")
+	// HttpStatusInternalServerError represents the `http.StatusInternalServerError` constant
+	HttpStatusInternalServerError = SimpleSelector("http", "StatusInternalServerError")
+
+	// HttpHandlerFuncFn is a construction of the `http.HandlerFunc` type conversion
+	HttpHandlerFuncFn = makeFunc(SimpleSelector("http", "HandlerFunc"), 1, false)
+	// HttpErrorFn is a construction of the `http.Error` function
+	HttpErrorFn = makeFunc(SimpleSelector("http", "Error"), 3, false)
+	// HttpNewRequestWithContextFn is a construction of the `http.NewRequestWithContext` function
+	HttpNewRequestWithContextFn = makeFunc(SimpleSelector("http", "NewRequestWithContext"), 4, false)
+	// UrlPathEscapeFn is a construction of the `url.PathEscape` function
+	UrlPathEscapeFn = makeFunc(SimpleSelector("url", "PathEscape"), 1, false)
+	// RequestContextFn is a construction of the `r.Context` function
+	RequestContextFn = makeFunc(SimpleSelector("r", "Context"), 0, false)
+	// RequestPathValueFn is a construction of the `r.PathValue` function
+	RequestPathValueFn = makeFunc(SimpleSelector("r", "PathValue"), 1, false)
+	// ResponseHeaderFn is a construction of the `w.Header` function
+	ResponseHeaderFn = makeFunc(SimpleSelector("w", "Header"), 0, false)
+	// ResponseWriteHeaderFn is a construction of the `w.WriteHeader` function
+	ResponseWriteHeaderFn = makeFunc(SimpleSelector("w", "WriteHeader"), 1, false)
+)
+
+// RequestContextMethod is a construction of the `<r>.Context` method of http.Request called on the given receiver
+func RequestContextMethod(r ast.Expr) CallFunctionDescriber {
+	return MethodOf(r, "Context", 0, false)
+}
+
+// RequestPathValueMethod is a construction of the `<r>.PathValue` method of http.Request called on the given receiver
+func RequestPathValueMethod(r ast.Expr) CallFunctionDescriber {
+	return MethodOf(r, "PathValue", 1, false)
+}
+
+// ResponseHeaderMethod is a construction of the `<w>.Header` method of http.ResponseWriter called on the given receiver
+func ResponseHeaderMethod(w ast.Expr) CallFunctionDescriber {
+	return MethodOf(w, "Header", 0, false)
+}
+
+// ResponseWriteHeaderMethod is a construction of the `<w>.WriteHeader` method of http.ResponseWriter called on the given receiver
+func ResponseWriteHeaderMethod(w ast.Expr) CallFunctionDescriber {
+	return MethodOf(w, "WriteHeader", 1, false)
+}
+
+type (
+	// HttpHandlerOptions describes the handler created with MakeHttpHandler
+	HttpHandlerOptions struct {
+		Name string
+		// Receiver makes the handler a method, can be nil
+		Receiver *ast.Field
+		// Method of the endpoint, the body is not decoded for GET, HEAD and DELETE
+		Method string
+		// Path and Params describe the path placeholders and the query parameters bound to the fields of the request,
+		// see HttpEndpoint
+		Path   string
+		Params []HttpParam
+		// RequestType is decoded from the JSON body and passed to the service after the context, can be nil
+		RequestType ast.Expr
+		// Service is the function called with the context and the request, it returns the response and the error
+		Service ast.Expr
+		// ErrorStatus is the function that maps the service error to the status code,
+		// http.StatusInternalServerError is used if nil
+		ErrorStatus ast.Expr
+		// NoContent is set if the service returns only the error, the handler replies with 204 No Content
+		NoContent bool
+	}
+)
+
+// MakeJsonEncode represents encoding to the writer
+//
+//	json.NewEncoder(<w>).Encode(<v>)
+func MakeJsonEncode(w, v ast.Expr) *ast.CallExpr {
+	return Call(InlineFunc(Selector(Call(JsonNewEncoder, w), "Encode")), v)
+}
+
+// MakeJsonDecode represents decoding from the reader to the pointer
+//
+//	json.NewDecoder(<r>).Decode(<ptr>)
+func MakeJsonDecode(r, ptr ast.Expr) *ast.CallExpr {
+	return Call(InlineFunc(Selector(Call(JsonNewDecoder, r), "Decode")), ptr)
+}
+
+// MakeHttpHandler declares the handler that decodes the request, binds the path placeholders and the query parameters,
+// calls the service and encodes the response
+//
+//	func <name>(w http.ResponseWriter, r *http.Request) {
+//	    var request <RequestType>
+//	    if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+//	        http.Error(w, err.Error(), http.StatusBadRequest)
+//	        return
+//	    }
+//	    response, err := <Service>(r.Context(), request)
+//	    if err != nil {
+//	        http.Error(w, err.Error(), <ErrorStatus>(err))
+//	        return
+//	    }
+//	    w.Header().Set("Content-Type", "application/json")
+//	    _ = json.NewEncoder(w).Encode(response)
+//	}
+func MakeHttpHandler(opts HttpHandlerOptions) ast.Decl {
+	var (
+		w        = ast.NewIdent("w")
+		r        = ast.NewIdent("r")
+		errVar   = ast.NewIdent("err")
+		request  = ast.NewIdent("request")
+		response = ast.NewIdent("response")
+		query    = ast.NewIdent("query")
+		args     = []ast.Expr{Call(RequestContextMethod(r))}
+		status   = HttpStatusInternalServerError
+		params   = makeHttpParams(HttpEndpoint{Name: opts.Name, Path: opts.Path, Params: opts.Params})
+		fn       = DeclareFunction(ast.NewIdent(opts.Name))
+	)
+	if opts.Receiver != nil {
+		fn.Receiver(opts.Receiver)
+	}
+	if opts.ErrorStatus != nil {
+		status = Call(InlineFunc(opts.ErrorStatus), errVar)
+	}
+	fn.Params(
+		Field(w.Name, nil, HttpResponseWriter),
+		Field(r.Name, nil, Star(HttpRequest)),
+	)
+	if opts.RequestType == nil && len(params) > 0 {
+		panic("parameters of the handler " + opts.Name + " require the request type")
+	}
+	if opts.RequestType != nil {
+		args = append(args, request)
+		fn.AppendStmt(Var(VariableType(request.Name, opts.RequestType)))
+		if hasHttpBody(opts.Method) {
+			fn.AppendStmt(IfInit(
+				Assign(VarNames{errVar}, Definition, MakeJsonDecode(Selector(r, "Body"), Ref(request))),
+				NotNil(errVar),
+				MakeHttpErrorStmt(w, errVar, HttpStatusBadRequest),
+				ReturnEmpty(),
+			))
+		}
+	}
+
+	var declareErr, declareQuery bool
+	for _, param := range params {
+		declareErr = declareErr || parseHttpParam(param.Type, EmptyString) != nil
+		declareQuery = declareQuery || param.Query != ""
+	}
+	if declareErr {
+		fn.AppendStmt(Var(VariableType(errVar.Name, ErrorType)))
+	}
+	if declareQuery {
+		fn.AppendStmt(Assign(VarNames{query}, Definition, Call(InlineFunc(Selector(Selector(r, "URL"), "Query")))))
+	}
+	for _, param := range params {
+		var (
+			field = Selector(request, param.Field)
+			value = Call(RequestPathValueMethod(r), StringConstant(param.Field).Expr())
+		)
+		if param.Query != "" {
+			value = Call(InlineFunc(Selector(query, "Get")), StringConstant(param.Query).Expr())
+		}
+		parse := parseHttpParam(param.Type, value)
+		if parse == nil {
+			fn.AppendStmt(Assign(VarNames{field}, Assignment, value))
+			continue
+		}
+		if param.Query != "" {
+			// the query parameters are optional, only the values passed are parsed
+			var raw = ast.NewIdent("value")
+			fn.AppendStmt(IfInit(
+				Assign(VarNames{raw}, Definition, value),
+				NotEqual(raw, EmptyString),
+				IfInit(
+					Assign(VarNames{field, errVar}, Assignment, parseHttpParam(param.Type, raw)),
+					NotNil(errVar),
+					MakeHttpErrorStmt(w, errVar, HttpStatusBadRequest),
+					ReturnEmpty(),
+				),
+			))
+			continue
+		}
+		fn.AppendStmt(IfInit(
+			Assign(VarNames{field, errVar}, Assignment, parse),
+			NotNil(errVar),
+			MakeHttpErrorStmt(w, errVar, HttpStatusBadRequest),
+			ReturnEmpty(),
+		))
+	}
+
+	var call = Call(InlineFunc(opts.Service), args...)
+	if opts.NoContent {
+		return fn.AppendStmt(
+			IfInit(
+				Assign(VarNames{errVar}, Definition, call),
+				NotNil(errVar),
+				MakeHttpErrorStmt(w, errVar, status),
+				ReturnEmpty(),
+			),
+			CallStmt(Call(ResponseWriteHeaderMethod(w), HttpStatusNoContent)),
+		).Decl()
+	}
+	return fn.AppendStmt(
+		Assign(VarNames{response, errVar}, Definition, call),
+		If(
+			NotNil(errVar),
+			MakeHttpErrorStmt(w, errVar, status),
+			ReturnEmpty(),
+		),
+		CallStmt(Call(
+			InlineFunc(Selector(Call(ResponseHeaderMethod(w)), "Set")),
+			StringConstant("Content-Type").Expr(),
+			StringConstant("application/json").Expr(),
+		)),
+		Assign(VarNames{Blank}, Assignment, MakeJsonEncode(w, response)),
+	).Decl()
+}
+
+// MakeHttpErrorStmt represents replying with the error text
+//
+//	http.Error(<w>, <err>.Error(), <status>)
+func MakeHttpErrorStmt(w, err, status ast.Expr) ast.Stmt {
+	return CallStmt(Call(HttpErrorFn, w, Call(InlineFunc(Selector(err, "Error"))), status))
+}
@@ -0,0 +1,239 @@
+package asthlp
+
+import (
+	"go/ast"
+	"go/token"
+	"net/http"
+	"strings"
+)
+
+type (
+	// HttpEndpoint describes the endpoint the client method is generated for
+	HttpEndpoint struct {
+		// Name of the client method
+		Name   string
+		Method string
+		// Path can contain placeholders `{Field}` replaced with the escaped fields of the request
+		Path string
+		// RequestType is encoded to the JSON body unless the method is GET, HEAD or DELETE, can be nil
+		RequestType ast.Expr
+		// ResponseType is decoded from the JSON body, the method returns only the error if nil
+		ResponseType ast.Expr
+		// Params describe the types of the path placeholders and the query parameters,
+		// the client encodes the query parameters and the server binds them
+		Params []HttpParam
+	}
+	// HttpClientOptions describes the client created with MakeHttpClient
+	HttpClientOptions struct {
+		// Name of the client struct, the constructor is New<Name>
+		Name      string
+		Endpoints []HttpEndpoint
+		// ErrorMapping is the function that maps the unsuccessful *http.Response to the error,
+		// the error with the status code is returned if nil
+		ErrorMapping ast.Expr
+	}
+)
+
+// MakeHttpClient creates the client struct, its constructor and the methods of the endpoints
+//
+//	type <name> struct {
+//	    baseURL    string
+//	    httpClient *http.Client
+//	}
+//
+//	func New<name>(baseURL string, httpClient *http.Client) *<name> {
+//	    return &<name>{baseURL: baseURL, httpClient: httpClient}
+//	}
+func MakeHttpClient(opts HttpClientOptions) []ast.Decl {
+	var (
+		name   = ast.NewIdent(opts.Name)
+		filler = StructTypeFiller(opts.Name)
+		recv   = Field("c", nil, Star(name))
+	)
+	filler.Field("baseURL", nil, String)
+	filler.Field("httpClient", nil, Star(HttpClient))
+	var decls = []ast.Decl{
+		&ast.GenDecl{Tok: token.TYPE, Specs: []ast.Spec{filler.TypeSpec()}},
+		DeclareFunction(ast.NewIdent("New"+opts.Name)).
+			Params(
+				Field("baseURL", nil, String),
+				Field("httpClient", nil, Star(HttpClient)),
+			).
+			Results(Field("", nil, Star(name))).
+			AppendStmt(Return(Ref(StructLiteral(name).
+				FillKeyValue("baseURL", ast.NewIdent("baseURL")).
+				FillKeyValue("httpClient", ast.NewIdent("httpClient")).
+				Expr()))).
+			Decl(),
+	}
+	for _, endpoint := range opts.Endpoints {
+		decls = append(decls, MakeHttpClientMethod(recv, endpoint, opts.ErrorMapping))
+	}
+	return decls
+}
+
+// MakeHttpClientMethod creates the method of the client calling the endpoint, the receiver must be named and have
+// the `baseURL` and `httpClient` fields
+//
+//	func (c *Client) <name>(ctx context.Context, request <RequestType>) (*<ResponseType>, error) {
+//	    var body bytes.Buffer
+//	    if err := json.NewEncoder(&body).Encode(request); err != nil {
+//	        return nil, err
+//	    }
+//	    query := url.Values{}
+//	    query.Set("filter", request.Filter)
+//	    query.Set("limit", fmt.Sprint(request.Limit))
+//	    req, err := http.NewRequestWithContext(ctx, "<method>", c.baseURL+"/users/"+url.PathEscape(fmt.Sprint(request.Id))+"?"+query.Encode(), &body)
+//	    if err != nil {
+//	        return nil, err
+//	    }
+//	    req.Header.Set("Content-Type", "application/json")
+//	    resp, err := c.httpClient.Do(req)
+//	    if err != nil {
+//	        return nil, err
+//	    }
+//	    defer resp.Body.Close()
+//	    if resp.StatusCode < 200 || resp.StatusCode > 299 {
+//	        return nil, <ErrorMapping>(resp)
+//	    }
+//	    var response <ResponseType>
+//	    if err = json.NewDecoder(resp.Body).Decode(&response); err != nil {
+//	        return nil, err
+//	    }
+//	    return &response, nil
+//	}
+func MakeHttpClientMethod(recv *ast.Field, endpoint HttpEndpoint, errorMapping ast.Expr) ast.Decl {
+	var (
+		c                 = receiverName(recv, endpoint.Name)
+		ctx               = ast.NewIdent("ctx")
+		errVar            = ast.NewIdent("err")
+		request           = ast.NewIdent("request")
+		response          = ast.NewIdent("response")
+		body              = ast.NewIdent("body")
+		req               = ast.NewIdent("req")
+		resp              = ast.NewIdent("resp")
+		query             = ast.NewIdent("query")
+		reader   ast.Expr = Nil
+		fn                = DeclareFunction(ast.NewIdent(endpoint.Name)).Receiver(recv)
+		failed   []ast.Expr
+		results  = []*ast.Field{Field("", nil, ErrorType)}
+	)
+	if endpoint.ResponseType != nil {
+		failed = append(failed, Nil)
+		results = append([]*ast.Field{Field("", nil, Star(endpoint.ResponseType))}, results...)
+	}
+	var fail = func(err ast.Expr) ast.Stmt {
+		return Return(append(failed[:len(failed):len(failed)], err)...)
+	}
+	var params = []*ast.Field{Field(ctx.Name, nil, ContextType)}
+	if endpoint.RequestType != nil {
+		params = append(params, Field(request.Name, nil, endpoint.RequestType))
+	}
+	fn.Params(params...).Results(results...)
+
+	if endpoint.RequestType != nil && hasHttpBody(endpoint.Method) {
+		reader = Ref(body)
+		fn.AppendStmt(
+			Var(VariableType(body.Name, BytesBuffer)),
+			IfInit(
+				Assign(VarNames{errVar}, Definition, MakeJsonEncode(Ref(body), request)),
+				NotNil(errVar),
+				fail(errVar),
+			),
+		)
+	}
+	var endpointURL = makeEndpointURL(Selector(c, "baseURL"), endpoint.Path, request)
+	if queryParams := makeHttpQueryParams(endpoint); len(queryParams) > 0 {
+		if endpoint.RequestType == nil {
+			panic("parameters of the endpoint " + endpoint.Name + " require the request type")
+		}
+		fn.AppendStmt(Assign(VarNames{query}, Definition, StructLiteral(UrlValues).Expr()))
+		for _, param := range queryParams {
+			fn.AppendStmt(CallStmt(Call(
+				MethodOf(query, "Set", 2, false),
+				StringConstant(param.Query).Expr(),
+				formatHttpParam(param.Type, Selector(request, param.Field)),
+			)))
+		}
+		endpointURL = Add(endpointURL, StringConstant("?").Expr(), Call(MethodOf(query, "Encode", 0, false)))
+	}
+	fn.AppendStmt(
+		Assign(VarNames{req, errVar}, Definition, Call(
+			HttpNewRequestWithContextFn,
+			ctx,
+			StringConstant(strings.ToUpper(endpoint.Method)).Expr(),
+			endpointURL,
+			reader,
+		)),
+		If(NotNil(errVar), fail(errVar)),
+	)
+	if reader != Nil {
+		fn.AppendStmt(CallStmt(Call(
+			InlineFunc(Selector(Selector(req, "Header"), "Set")),
+			StringConstant("Content-Type").Expr(),
+			StringConstant("application/json").Expr(),
+		)))
+	}
+
+	var (
+		status    = Selector(resp, "StatusCode")
+		statusErr = Call(FmtErrorfFn, StringConstant("unexpected status %d").Expr(), status)
+	)
+	if errorMapping != nil {
+		statusErr = Call(InlineFunc(errorMapping), resp)
+	}
+	fn.AppendStmt(
+		Assign(VarNames{resp, errVar}, Definition, Call(InlineFunc(Selector(Selector(c, "httpClient"), "Do")), req)),
+		If(NotNil(errVar), fail(errVar)),
+		DeferCall(InlineFunc(Selector(Selector(resp, "Body"), "Close"))),
+		If(
+			Or(
+				Binary(status, IntegerConstant(200).Expr(), token.LSS),
+				Great(status, IntegerConstant(299).Expr()),
+			),
+			fail(statusErr),
+		),
+	)
+	if endpoint.ResponseType == nil {
+		return fn.AppendStmt(Return(Nil)).Decl()
+	}
+	return fn.AppendStmt(
+		Var(VariableType(response.Name, endpoint.ResponseType)),
+		IfInit(
+			Assign(VarNames{errVar}, Assignment, MakeJsonDecode(Selector(resp, "Body"), Ref(response))),
+			NotNil(errVar),
+			fail(errVar),
+		),
+		Return(Ref(response), Nil),
+	).Decl()
+}
+
+func hasHttpBody(method string) bool {
+	switch strings.ToUpper(method) {
+	case http.MethodGet, http.MethodHead, http.MethodDelete:
+		return false
+	default:
+		return true
+	}
+}
+
+// makeEndpointURL concatenates the base with the path, the `{Field}` placeholders are replaced with the escaped fields of the request
+func makeEndpointURL(base ast.Expr, path string, request ast.Expr) ast.Expr {
+	var parts = []ast.Expr{base}
+	for _, segment := range splitHttpPath(path) {
+		if segment.Field == "" {
+			parts = append(parts, StringConstant(segment.Text).Expr())
+			continue
+		}
+		parts = append(parts, Call(UrlPathEscapeFn, Call(FmtSprintFn, Selector(request, segment.Field))))
+	}
+	return Add(parts...)
+}
+
+// formatHttpParam converts the field to the string, the type is described in HttpParam
+func formatHttpParam(t, field ast.Expr) ast.Expr {
+	if ident, ok := t.(*ast.Ident); t == nil || ok && ident.Name == String.Name {
+		return field
+	}
+	return Call(FmtSprintFn, field)
+}
@@ -0,0 +1,57 @@
+package asthlp
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/iv-menshenin/go-ast/explorer"
+)
+
+const httpSupport = `package p
+
+type (
+	GetUserRequest struct {
+		Id     int64
+		Filter string
+		Limit  int
+	}
+	User struct {
+		Id int64
+	}
+)
+`
+
+var httpEndpoints = []HttpEndpoint{
+	{
+		Name:         "GetUser",
+		Method:       "GET",
+		Path:         "/users/{Id}",
+		RequestType:  ast.NewIdent("GetUserRequest"),
+		ResponseType: ast.NewIdent("User"),
+		Params: []HttpParam{
+			{Field: "Id", Type: Int64},
+			{Field: "Filter", Query: "filter", Type: String},
+			{Field: "Limit", Query: "limit", Type: Int},
+		},
+	},
+	{
+		Name:        "UpdateUser",
+		Method:      "PUT",
+		Path:        "/users/{Id}",
+		RequestType: ast.NewIdent("User"),
+		Params:      []HttpParam{{Field: "Id", Type: Int64}},
+	},
+	{
+		Name:         "ListUsers",
+		Method:       "GET",
+		Path:         "/users",
+		ResponseType: ArrayType(ast.NewIdent("User")),
+	},
+}
+
+func TestMakeHttpClient_TypeCheck(t *testing.T) {
+	typeCheck(t, explorer.New(), nil, httpSupport, MakeHttpClient(HttpClientOptions{
+		Name:      "Client",
+		Endpoints: httpEndpoints,
+	})...)
+}
@@ -0,0 +1,246 @@
+package asthlp
+
+import (
+	"go/ast"
+	"go/token"
+	"strings"
+)
+
+type (
+	// HttpParam describes the path placeholder or the query parameter bound to the field of the request
+	HttpParam struct {
+		// Field of the request, the path placeholder `{Field}` refers to it
+		Field string
+		// Type is one of String, Int, Int64, UInt64, Float64 or Bool, String is used if nil
+		Type ast.Expr
+		// Query is the name of the query parameter, the parameter is taken from the path if empty
+		Query string
+	}
+	// HttpServerOptions describes the server created with MakeHttpServer
+	HttpServerOptions struct {
+		// Name of the handlers struct, the constructor is New<Name> and the registration is Register<Name>
+		Name string
+		// Service is the name of the interface declared with the methods of the endpoints
+		Service   string
+		Endpoints []HttpEndpoint
+		// ErrorStatus is the function that maps the service error to the status code,
+		// http.StatusInternalServerError is used if nil
+		ErrorStatus ast.Expr
+	}
+)
+
+// MakeHttpServer creates the service interface, the handlers struct with its constructor, the handler methods
+// of the endpoints and the route registration. The service methods have the same signatures as the methods
+// created with MakeHttpClient, so the client implements the service
+//
+//	type <service> interface {
+//	    <endpoint>(ctx context.Context, request <RequestType>) (*<ResponseType>, error)
+//	}
+//
+//	type <name> struct {
+//	    service <service>
+//	}
+//
+//	func New<name>(service <service>) *<name> {
+//	    return &<name>{service: service}
+//	}
+func MakeHttpServer(opts HttpServerOptions) []ast.Decl {
+	var (
+		name    = ast.NewIdent(opts.Name)
+		service = ast.NewIdent(opts.Service)
+		iface   = InterfaceTypeFiller(opts.Service)
+		filler  = StructTypeFiller(opts.Name)
+		recv    = Field("h", nil, Star(name))
+		routes  = make([]Route, 0, len(opts.Endpoints))
+	)
+	for _, endpoint := range opts.Endpoints {
+		iface.Method(makeHttpServiceMethod(endpoint), nil)
+		routes = append(routes, Route{Method: endpoint.Method, Path: endpoint.Path, Handler: endpoint.Name})
+	}
+	filler.Field("service", nil, service)
+	var decls = []ast.Decl{
+		&ast.GenDecl{Tok: token.TYPE, Specs: []ast.Spec{iface.TypeSpec()}},
+		&ast.GenDecl{Tok: token.TYPE, Specs: []ast.Spec{filler.TypeSpec()}},
+		DeclareFunction(ast.NewIdent("New" + opts.Name)).
+			Params(Field("service", nil, service)).
+			Results(Field("", nil, Star(name))).
+			AppendStmt(Return(Ref(StructLiteral(name).
+				FillKeyValue("service", ast.NewIdent("service")).
+				Expr()))).
+			Decl(),
+	}
+	for _, endpoint := range opts.Endpoints {
+		decls = append(decls, MakeHttpServerMethod(recv, endpoint, opts.ErrorStatus))
+	}
+	return append(decls, MakeHttpRouteRegistration("Register"+opts.Name, Star(name), routes...))
+}
+
+func makeHttpServiceMethod(endpoint HttpEndpoint) MethodDecl {
+	var (
+		params  = []*ast.Field{Field("ctx", nil, ContextType)}
+		results = []*ast.Field{Field("", nil, ErrorType)}
+	)
+	if endpoint.RequestType != nil {
+		params = append(params, Field("request", nil, endpoint.RequestType))
+	}
+	if endpoint.ResponseType != nil {
+		results = append([]*ast.Field{Field("", nil, Star(endpoint.ResponseType))}, results...)
+	}
+	return DeclareMethod(ast.NewIdent(endpoint.Name)).Params(params...).Results(results...)
+}
+
+// MakeHttpServerMethod creates the handler method of the endpoint with MakeHttpHandler, the receiver must be named and have
+// the `service` field. The body is decoded unless the method is GET, HEAD or DELETE, then the path placeholders
+// and the query parameters are bound to the fields of the request, the empty or absent query parameters are not parsed
+//
+//	func (h *Handlers) <name>(w http.ResponseWriter, r *http.Request) {
+//	    var request <RequestType>
+//	    var err error
+//	    query := r.URL.Query()
+//	    if request.Id, err = strconv.ParseInt(r.PathValue("Id"), 10, 64); err != nil {
+//	        http.Error(w, err.Error(), http.StatusBadRequest)
+//	        return
+//	    }
+//	    request.Filter = query.Get("filter")
+//	    if value := query.Get("limit"); value != "" {
+//	        if request.Limit, err = strconv.Atoi(value); err != nil {
+//	            http.Error(w, err.Error(), http.StatusBadRequest)
+//	            return
+//	        }
+//	    }
+//	    response, err := h.service.<name>(r.Context(), request)
+//	    ...
+//	}
+func MakeHttpServerMethod(recv *ast.Field, endpoint HttpEndpoint, errorStatus ast.Expr) ast.Decl {
+	return MakeHttpHandler(HttpHandlerOptions{
+		Name:        endpoint.Name,
+		Receiver:    recv,
+		Method:      endpoint.Method,
+		Path:        endpoint.Path,
+		Params:      endpoint.Params,
+		RequestType: endpoint.RequestType,
+		Service:     Selector(Selector(receiverName(recv, endpoint.Name), "service"), endpoint.Name),
+		ErrorStatus: errorStatus,
+		NoContent:   endpoint.ResponseType == nil,
+	})
+}
+
+// MakeHttpRouteRegistration declares the function registering the handler methods with the http.ServeMux,
+// the method is prepended to the path as the pattern requires
+//
+//	func <name>(mux *http.ServeMux, h <handlersType>) {
+//	    mux.HandleFunc("GET /users/{id}", h.GetUser)
+//	    mux.HandleFunc("POST /users", h.CreateUser)
+//	}
+func MakeHttpRouteRegistration(name string, handlersType ast.Expr, routes ...Route) ast.Decl {
+	var (
+		mux = ast.NewIdent("mux")
+		h   = ast.NewIdent("h")
+	)
+	var fn = DeclareFunction(ast.NewIdent(name)).
+		Params(
+			Field(mux.Name, nil, Star(HttpServeMux)),
+			Field(h.Name, nil, handlersType),
+		)
+	for _, route := range routes {
+		fn.AppendStmt(CallStmt(Call(
+			MethodOf(mux, "HandleFunc", 2, false),
+			StringConstant(strings.ToUpper(route.Method)+" "+route.Path).Expr(),
+			Selector(h, route.Handler),
+		)))
+	}
+	return fn.Decl()
+}
+
+type (
+	// httpPathSegment is the text of the path or the placeholder referring to the field
+	httpPathSegment struct {
+		Text  string
+		Field string
+	}
+)
+
+// splitHttpPath splits the path into the text and the placeholders, the `...` suffix of the wildcard
+// placeholders like `{Path...}` is not the part of the field name
+func splitHttpPath(path string) []httpPathSegment {
+	var (
+		segments []httpPathSegment
+		rest     = path
+	)
+	for rest != "" {
+		open := strings.Index(rest, "{")
+		if open < 0 {
+			segments = append(segments, httpPathSegment{Text: rest})
+			break
+		}
+		shut := strings.Index(rest[open:], "}")
+		if shut < 0 {
+			panic("unclosed placeholder in the path " + path)
+		}
+		if open > 0 {
+			segments = append(segments, httpPathSegment{Text: rest[:open]})
+		}
+		segments = append(segments, httpPathSegment{
+			Text:  rest[open : open+shut+1],
+			Field: strings.TrimSuffix(rest[open+1:open+shut], "..."),
+		})
+		rest = rest[open+shut+1:]
+	}
+	return segments
+}
+
+// makeHttpParams returns the path placeholders in order of appearance followed by the query parameters,
+// the placeholders not described in the params of the endpoint are strings
+func makeHttpParams(endpoint HttpEndpoint) []HttpParam {
+	var params []HttpParam
+	for _, segment := range splitHttpPath(endpoint.Path) {
+		if segment.Field == "" {
+			continue
+		}
+		var param = HttpParam{Field: segment.Field}
+		for _, p := range endpoint.Params {
+			if p.Query == "" && p.Field == param.Field {
+				param = p
+			}
+		}
+		params = append(params, param)
+	}
+	return append(params, makeHttpQueryParams(endpoint)...)
+}
+
+func makeHttpQueryParams(endpoint HttpEndpoint) []HttpParam {
+	var params []HttpParam
+	for _, p := range endpoint.Params {
+		if p.Query != "" {
+			params = append(params, p)
+		}
+	}
+	return params
+}
+
+// parseHttpParam returns the strconv call parsing the value of the type, nil is returned for strings
+func parseHttpParam(t, value ast.Expr) ast.Expr {
+	if t == nil {
+		return nil
+	}
+	var name string
+	if ident, ok := t.(*ast.Ident); ok {
+		name = ident.Name
+	}
+	switch name {
+	case "string":
+		return nil
+	case "int":
+		return Call(StrconvAtoiFn, value)
+	case "int64":
+		return Call(StrconvParseIntFn, value, IntegerConstant(10).Expr(), IntegerConstant(64).Expr())
+	case "uint64":
+		return Call(StrconvParseUintFn, value, IntegerConstant(10).Expr(), IntegerConstant(64).Expr())
+	case "float64":
+		return Call(StrconvParseFloatFn, value, IntegerConstant(64).Expr())
+	case "bool":
+		return Call(StrconvParseBoolFn, value)
+	default:
+		panic("unsupported type of the parameter " + name)
+	}
+}
@@ -0,0 +1,26 @@
+package asthlp
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/iv-menshenin/go-ast/explorer"
+)
+
+func TestMakeHttpServer_TypeCheck(t *testing.T) {
+	var decls = MakeHttpServer(HttpServerOptions{
+		Name:        "Handlers",
+		Service:     "Service",
+		Endpoints:   httpEndpoints,
+		ErrorStatus: ast.NewIdent("errorStatus"),
+	})
+	// the client implements the service
+	decls = append(decls, MakeHttpClient(HttpClientOptions{Name: "Client", Endpoints: httpEndpoints})...)
+	typeCheck(t, explorer.New(), nil, httpSupport+`
+var _ Service = (*Client)(nil)
+
+func errorStatus(err error) int {
+	return 500
+}
+`, decls...)
+}
@@ -0,0 +1,251 @@
+package asthlp
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/types"
+	"strings"
+
+	"github.com/iv-menshenin/go-ast/explorer"
+)
+
+type (
+	// StructMapperOptions describes the functions created with MakeStructMapper
+	StructMapperOptions struct {
+		// Package is the package the functions are generated into, its types are not qualified, can be nil
+		Package *types.Package
+		// MatchName reports whether the source field is copied to the destination field,
+		// the names are compared ignoring case and underscores if nil
+		MatchName func(src, dst string) bool
+		// Imports is the discoverer of the file the functions are added to, the identifiers of the packages
+		// of the mapped types are bound with it. Required if the types are declared outside the Package
+		Imports *explorer.Discoverer
+	}
+	structMapper struct {
+		opts     StructMapperOptions
+		decls    []ast.Decl
+		declared map[string]bool
+		refs     *explorer.PackageRefs
+	}
+)
+
+// MakeStructMapper creates the function copying the matching fields of the source struct to the destination struct.
+// The convertible types are converted, the nested structs, pointers to them and slices of them are copied with
+// the functions created the same way and returned along with the mapper. The destination fields without
+// the matching source field or with the incompatible type are left as they are and commented
+//
+//	func Map<src>To<dst>(src <src>) <dst> {
+//	    var dst <dst>
+//	    dst.Id = src.Id
+//	    dst.Amount = int64(src.Amount)
+//	    dst.Address = MapAddressToAddress(src.Address)
+//	    if src.Manager != nil {
+//	        var v Manager
+//	        v = MapManagerToManager(*src.Manager)
+//	        dst.Manager = &v
+//	    }
+//	    if src.Items != nil {
+//	        dst.Items = make([]Item, len(src.Items))
+//	        for i := range src.Items {
+//	            dst.Items[i] = MapItemToItem(src.Items[i])
+//	        }
+//	    }
+//	    // Secret is not mapped
+//	    return dst
+//	}
+func MakeStructMapper(src, dst *types.Named, opts StructMapperOptions) []ast.Decl {
+	if opts.MatchName == nil {
+		opts.MatchName = matchFieldName
+	}
+	var m = structMapper{
+		opts:     opts,
+		declared: make(map[string]bool),
+		refs:     explorer.NewPackageRefs(),
+	}
+	m.declare(src, dst)
+	if opts.Imports != nil {
+		m.refs.Bind(opts.Imports)
+	}
+	return m.decls
+}
+
+// StructMapperName returns the name of the function created with MakeStructMapper
+func StructMapperName(src, dst *types.Named) string {
+	return "Map" + src.Obj().Name() + "To" + dst.Obj().Name()
+}
+
+func matchFieldName(src, dst string) bool {
+	return strings.EqualFold(strings.ReplaceAll(src, "_", ""), strings.ReplaceAll(dst, "_", ""))
+}
+
+// declare appends the mapper of the pair unless it is already declared, the mappers of the nested structs
+// are appended before the mapper using them
+func (m *structMapper) declare(src, dst *types.Named) string {
+	var name = StructMapperName(src, dst)
+	if m.declared[name] {
+		return name
+	}
+	m.declared[name] = true
+	var (
+		srcVar    = ast.NewIdent("src")
+		dstVar    = ast.NewIdent("dst")
+		srcStruct = src.Underlying().(*types.Struct)
+		dstStruct = dst.Underlying().(*types.Struct)
+		fn        = DeclareFunction(ast.NewIdent(name)).
+				Params(Field(srcVar.Name, nil, m.typeExpr(src))).
+				Results(Field("", nil, m.typeExpr(dst)))
+	)
+	fn.AppendStmt(Var(VariableType(dstVar.Name, m.typeExpr(dst))))
+	for i := 0; i < dstStruct.NumFields(); i++ {
+		var dstField = dstStruct.Field(i)
+		if !m.accessible(dstField) {
+			continue
+		}
+		var srcField = m.lookupField(srcStruct, dstField.Name())
+		if srcField == nil {
+			fn.AppendStmt(CommentStmt(dstField.Name() + " is not mapped"))
+			continue
+		}
+		stmts := m.assign(Selector(dstVar, dstField.Name()), Selector(srcVar, srcField.Name()), srcField.Type(), dstField.Type(), 0)
+		if stmts == nil {
+			fn.AppendStmt(CommentStmt(dstField.Name() + " is not mapped, the types are incompatible"))
+			continue
+		}
+		fn.AppendStmt(stmts...)
+	}
+	m.decls = append(m.decls, fn.AppendStmt(Return(dstVar)).Decl())
+	return name
+}
+
+func (m *structMapper) lookupField(s *types.Struct, name string) *types.Var {
+	for i := 0; i < s.NumFields(); i++ {
+		if field := s.Field(i); m.accessible(field) && m.opts.MatchName(field.Name(), name) {
+			return field
+		}
+	}
+	return nil
+}
+
+func (m *structMapper) accessible(field *types.Var) bool {
+	return field.Exported() || (m.opts.Package != nil && field.Pkg() == m.opts.Package)
+}
+
+// assign returns the statements copying the source to the destination, nil is returned if the types are incompatible.
+// The depth is the nesting level of the loops over the slices
+func (m *structMapper) assign(dst, src ast.Expr, srcType, dstType types.Type, depth int) []ast.Stmt {
+	if types.Identical(srcType, dstType) {
+		return []ast.Stmt{Assign(VarNames{dst}, Assignment, src)}
+	}
+	if convertibleField(srcType, dstType) {
+		return []ast.Stmt{Assign(VarNames{dst}, Assignment, ExpressionTypeConvert(src, m.typeExpr(dstType)))}
+	}
+	srcNamed, srcOk := structNamed(srcType)
+	dstNamed, dstOk := structNamed(dstType)
+	if srcOk && dstOk {
+		var name = m.declare(srcNamed, dstNamed)
+		return []ast.Stmt{Assign(VarNames{dst}, Assignment, Call(InlineFunc(ast.NewIdent(name)), src))}
+	}
+
+	srcPtr, srcIsPtr := srcType.(*types.Pointer)
+	dstPtr, dstIsPtr := dstType.(*types.Pointer)
+	switch {
+	case srcIsPtr && dstIsPtr:
+		var v = ast.NewIdent("v")
+		stmts := m.assign(v, Star(src), srcPtr.Elem(), dstPtr.Elem(), depth)
+		if stmts == nil {
+			return nil
+		}
+		return []ast.Stmt{If(NotNil(src), append(append(
+			[]ast.Stmt{Var(VariableType(v.Name, m.typeExpr(dstPtr.Elem())))}, stmts...),
+			Assign(VarNames{dst}, Assignment, Ref(v)),
+		)...)}
+	case srcIsPtr:
+		stmts := m.assign(dst, Star(src), srcPtr.Elem(), dstType, depth)
+		if stmts == nil {
+			return nil
+		}
+		return []ast.Stmt{If(NotNil(src), stmts...)}
+	case dstIsPtr:
+		var v = ast.NewIdent("v")
+		stmts := m.assign(v, src, srcType, dstPtr.Elem(), depth)
+		if stmts == nil {
+			return nil
+		}
+		return []ast.Stmt{Block(append(append(
+			[]ast.Stmt{Var(VariableType(v.Name, m.typeExpr(dstPtr.Elem())))}, stmts...),
+			Assign(VarNames{dst}, Assignment, Ref(v)),
+		)...)}
+	}
+
+	srcSlice, srcIsSlice := srcType.Underlying().(*types.Slice)
+	dstSlice, dstIsSlice := dstType.Underlying().(*types.Slice)
+	if srcIsSlice && dstIsSlice {
+		var (
+			index = ast.NewIdent(string(rune('i' + depth)))
+			stmts = m.assign(Index(dst, VariableName(index.Name)), Index(src, VariableName(index.Name)), srcSlice.Elem(), dstSlice.Elem(), depth+1)
+		)
+		if stmts == nil {
+			return nil
+		}
+		return []ast.Stmt{If(
+			NotNil(src),
+			Assign(VarNames{dst}, Assignment, Call(MakeFn, m.typeExpr(dstType), Call(LengthFn, src))),
+			Range(true, index.Name, "", src, stmts...),
+		)}
+	}
+	return nil
+}
+
+// convertibleField excludes the conversions of integers to strings, which produce runes instead of digits
+func convertibleField(srcType, dstType types.Type) bool {
+	if !types.ConvertibleTo(srcType, dstType) {
+		return false
+	}
+	srcBasic, srcOk := srcType.Underlying().(*types.Basic)
+	dstBasic, dstOk := dstType.Underlying().(*types.Basic)
+	if srcOk && dstOk && srcBasic.Info()&types.IsInteger != 0 && dstBasic.Info()&types.IsString != 0 {
+		return false
+	}
+	return true
+}
+
+func structNamed(t types.Type) (*types.Named, bool) {
+	named, ok := t.(*types.Named)
+	if !ok {
+		return nil, false
+	}
+	_, ok = named.Underlying().(*types.Struct)
+	return named, ok
+}
+
+// typeExpr converts the type to the expression, the packages of the named types are referred to by import path
+// with the identifiers of explorer.PackageRefs
+func (m *structMapper) typeExpr(t types.Type) ast.Expr {
+	var (
+		refs      = make(map[string]*ast.Ident)
+		qualifier = func(pkg *types.Package) string {
+			if pkg == m.opts.Package {
+				return ""
+			}
+			if m.opts.Imports == nil {
+				panic("StructMapperOptions.Imports is required to refer to the package " + pkg.Path())
+			}
+			var ref = m.refs.Ident(explorer.Package{Path: pkg.Path(), Kind: packageKind(pkg.Path())}, pkg.Name())
+			refs[ref.Name] = ref
+			return ref.Name
+		}
+	)
+	expr, err := parser.ParseExpr(types.TypeString(t, qualifier))
+	if err != nil {
+		panic("cannot express the type " + t.String() + ": " + err.Error())
+	}
+	ast.Inspect(expr, func(node ast.Node) bool {
+		if sel, ok := node.(*ast.SelectorExpr); ok {
+			if x, ok := sel.X.(*ast.Ident); ok && refs[x.Name] != nil {
+				sel.X = refs[x.Name]
+			}
+		}
+		return true
+	})
+	return expr
+}
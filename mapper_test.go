@@ -0,0 +1,80 @@
+package asthlp
+
+import (
+	"go/types"
+	"testing"
+
+	"github.com/iv-menshenin/go-ast/explorer"
+)
+
+var mapperStubs = map[string]string{
+	"example.com/app/errors": `package errors
+
+type Code int
+`,
+	"example.com/app/model": `package model
+
+type (
+	User struct {
+		Id      int64
+		Amount  int32
+		Code    int
+		Address Address
+		Manager *User
+		Items   []Item
+		Secret  string
+	}
+	Address struct {
+		City string
+	}
+	Item struct {
+		Name string
+	}
+)
+`,
+	"example.com/app/dto": `package dto
+
+import "example.com/app/errors"
+
+type (
+	User struct {
+		Id      int64
+		Amount  int64
+		Code    errors.Code
+		Address Address
+		Manager *User
+		Items   []Item
+		Extra   string
+	}
+	Address struct {
+		City string
+		Zip  string
+	}
+	Item struct {
+		Name string
+	}
+)
+`,
+}
+
+func lookupNamed(t *testing.T, imp types.Importer, path, name string) *types.Named {
+	t.Helper()
+	pkg, err := imp.Import(path)
+	if err != nil {
+		t.Fatalf("cannot import %s: %v", path, err)
+	}
+	return pkg.Scope().Lookup(name).Type().(*types.Named)
+}
+
+func TestMakeStructMapper_TypeCheck(t *testing.T) {
+	var (
+		imp = newStubImporter(mapperStubs)
+		d   = explorer.New()
+	)
+	var decls = MakeStructMapper(
+		lookupNamed(t, imp, "example.com/app/model", "User"),
+		lookupNamed(t, imp, "example.com/app/dto", "User"),
+		StructMapperOptions{Imports: d},
+	)
+	typeCheck(t, d, mapperStubs, "package p\n", decls...)
+}
@@ -0,0 +1,92 @@
+package asthlp
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+)
+
+var (
+	// MathMaxInt8 represents the `math.MaxInt8` constant
+	MathMaxInt8 = SimpleSelector("math", "MaxInt8")
+	// MathMinInt8 represents the `math.MinInt8` constant
+	MathMinInt8 = SimpleSelector("math", "MinInt8")
+	// MathMaxInt16 represents the `math.MaxInt16` constant
+	MathMaxInt16 = SimpleSelector("math", "MaxInt16")
+	// MathMinInt16 represents the `math.MinInt16` constant
+	MathMinInt16 = SimpleSelector("math", "MinInt16")
+	// MathMaxInt32 represents the `math.MaxInt32` constant
+	MathMaxInt32 = SimpleSelector("math", "MaxInt32")
+	// MathMinInt32 represents the `math.MinInt32` constant
+	MathMinInt32 = SimpleSelector("math", "MinInt32")
+	// MathMaxInt64 represents the `math.MaxInt64` constant
+	MathMaxInt64 = SimpleSelector("math", "MaxInt64")
+	// MathMinInt64 represents the `math.MinInt64` constant
+	MathMinInt64 = SimpleSelector("math", "MinInt64")
+	// MathMaxUint8 represents the `math.MaxUint8` constant
+	MathMaxUint8 = SimpleSelector("math", "MaxUint8")
+	// MathMaxUint16 represents the `math.MaxUint16` constant
+	MathMaxUint16 = SimpleSelector("math", "MaxUint16")
+	// MathMaxUint32 represents the `math.MaxUint32` constant
+	MathMaxUint32 = SimpleSelector("math", "MaxUint32")
+	// MathMaxFloat32 represents the `math.MaxFloat32` constant
+	MathMaxFloat32 = SimpleSelector("math", "MaxFloat32")
+	// MathMaxFloat64 represents the `math.MaxFloat64` constant
+	MathMaxFloat64 = SimpleSelector("math", "MaxFloat64")
+
+	// MathAbsFn is a construction of the `math.Abs` function
+	MathAbsFn = makeFunc(SimpleSelector("math", "Abs"), 1, false)
+	// MathMaxFn is a construction of the `math.Max` function
+	MathMaxFn = makeFunc(SimpleSelector("math", "Max"), 2, false)
+	// MathMinFn is a construction of the `math.Min` function
+	MathMinFn = makeFunc(SimpleSelector("math", "Min"), 2, false)
+	// MathRoundFn is a construction of the `math.Round` function
+	MathRoundFn = makeFunc(SimpleSelector("math", "Round"), 1, false)
+	// MathFloorFn is a construction of the `math.Floor` function
+	MathFloorFn = makeFunc(SimpleSelector("math", "Floor"), 1, false)
+	// MathCeilFn is a construction of the `math.Ceil` function
+	MathCeilFn = makeFunc(SimpleSelector("math", "Ceil"), 1, false)
+	// MathTruncFn is a construction of the `math.Trunc` function
+	MathTruncFn = makeFunc(SimpleSelector("math", "Trunc"), 1, false)
+	// MathSqrtFn is a construction of the `math.Sqrt` function
+	MathSqrtFn = makeFunc(SimpleSelector("math", "Sqrt"), 1, false)
+	// MathPowFn is a construction of the `math.Pow` function
+	MathPowFn = makeFunc(SimpleSelector("math", "Pow"), 2, false)
+	// MathIsNaNFn is a construction of the `math.IsNaN` function
+	MathIsNaNFn = makeFunc(SimpleSelector("math", "IsNaN"), 1, false)
+	// MathIsInfFn is a construction of the `math.IsInf` function
+	MathIsInfFn = makeFunc(SimpleSelector("math", "IsInf"), 2, false)
+)
+
+// MakeCheckedConversionFunc declares the function that converts the number and reports the overflow.
+// Bounds are the limits of the target type, nil bound is not checked
+//
+//	func <name>(v <from>) (<to>, error) {
+//	    if v < <minValue> || v > <maxValue> {
+//	        return 0, fmt.Errorf("value %v overflows <to>", v)
+//	    }
+//	    return <to>(v), nil
+//	}
+func MakeCheckedConversionFunc(name string, from, to, minValue, maxValue ast.Expr) ast.Decl {
+	var (
+		v      = ast.NewIdent("v")
+		bounds []ast.Expr
+	)
+	if minValue != nil {
+		bounds = append(bounds, Binary(v, minValue, token.LSS))
+	}
+	if maxValue != nil {
+		bounds = append(bounds, Binary(v, maxValue, token.GTR))
+	}
+	var fn = DeclareFunction(ast.NewIdent(name)).
+		Comments("// "+name+" converts "+types.ExprString(from)+" to "+types.ExprString(to)+", returns an error on overflow").
+		Params(Field(v.Name, nil, from)).
+		Results(Field("", nil, to), Field("", nil, ErrorType))
+	if len(bounds) > 0 {
+		fn.AppendStmt(If(
+			Or(bounds[0], bounds[1:]...),
+			Return(Zero, Call(FmtErrorfFn, StringConstant("value %v overflows "+types.ExprString(to)).Expr(), v)),
+		))
+	}
+	return fn.AppendStmt(Return(ExpressionTypeConvert(v, to), Nil)).Decl()
+}
@@ -0,0 +1,179 @@
+package asthlp
+
+import (
+	"go/ast"
+	"go/token"
+)
+
+// MakeMaybeType declares the wrapper of the value type distinguishing the omitted value from the zero one, with the
+// methods IsOmitted, Get, Set, MarshalJSON, UnmarshalJSON, Scan and Value. JSON null and SQL NULL are omitted values.
+// The value is stored in the database as the representation, the value type is used if the representation is nil
+//
+//	type <name> struct {
+//	    value   <valueType>
+//	    present bool
+//	}
+//
+//	func (m <name>) IsOmitted() bool {
+//	    return !m.present
+//	}
+//
+//	func (m <name>) Get() (<valueType>, bool) {
+//	    return m.value, m.present
+//	}
+//
+//	func (m *<name>) Set(value <valueType>) {
+//	    m.value = value
+//	    m.present = true
+//	}
+func MakeMaybeType(name string, valueType, representation ast.Expr) []ast.Decl {
+	var (
+		typeName = ast.NewIdent(name)
+		m        = ast.NewIdent("m")
+		value    = ast.NewIdent("value")
+		filler   = StructTypeFiller(name)
+	)
+	if representation == nil {
+		representation = valueType
+	}
+	filler.Field("value", nil, valueType)
+	filler.Field("present", nil, Bool)
+	return []ast.Decl{
+		&ast.GenDecl{Tok: token.TYPE, Specs: []ast.Spec{filler.TypeSpec()}},
+		DeclareFunction(ast.NewIdent("IsOmitted")).
+			Receiver(Field(m.Name, nil, typeName)).
+			Results(Field("", nil, Bool)).
+			AppendStmt(Return(Not(Selector(m, "present")))).
+			Decl(),
+		DeclareFunction(ast.NewIdent("Get")).
+			Receiver(Field(m.Name, nil, typeName)).
+			Results(Field("", nil, valueType), Field("", nil, Bool)).
+			AppendStmt(Return(Selector(m, "value"), Selector(m, "present"))).
+			Decl(),
+		DeclareFunction(ast.NewIdent("Set")).
+			Receiver(Field(m.Name, nil, Star(typeName))).
+			Params(Field(value.Name, nil, valueType)).
+			AppendStmt(
+				Assign(VarNames{Selector(m, "value")}, Assignment, value),
+				Assign(VarNames{Selector(m, "present")}, Assignment, True),
+			).
+			Decl(),
+		makeMaybeMarshalJSON(typeName),
+		makeMaybeUnmarshalJSON(typeName),
+		makeMaybeScan(typeName, valueType, representation),
+		makeMaybeValue(typeName, valueType, representation),
+	}
+}
+
+func makeMaybeMarshalJSON(typeName *ast.Ident) ast.Decl {
+	var m = ast.NewIdent("m")
+	return DeclareFunction(ast.NewIdent("MarshalJSON")).
+		Receiver(Field(m.Name, nil, typeName)).
+		Results(Field("", nil, ArrayType(Byte)), Field("", nil, ErrorType)).
+		AppendStmt(
+			If(
+				Not(Selector(m, "present")),
+				Return(ExpressionTypeConvert(StringConstant("null").Expr(), ArrayType(Byte)), Nil),
+			),
+			Return(Call(JsonMarshal, Selector(m, "value"))),
+		).
+		Decl()
+}
+
+// makeMaybeUnmarshalJSON treats null as the omitted value
+//
+//	func (m *<name>) UnmarshalJSON(data []byte) error {
+//	    if string(data) == "null" {
+//	        *m = <name>{}
+//	        return nil
+//	    }
+//	    if err := json.Unmarshal(data, &m.value); err != nil {
+//	        return err
+//	    }
+//	    m.present = true
+//	    return nil
+//	}
+func makeMaybeUnmarshalJSON(typeName *ast.Ident) ast.Decl {
+	var (
+		m      = ast.NewIdent("m")
+		data   = ast.NewIdent("data")
+		errVar = ast.NewIdent("err")
+	)
+	return DeclareFunction(ast.NewIdent("UnmarshalJSON")).
+		Receiver(Field(m.Name, nil, Star(typeName))).
+		Params(Field(data.Name, nil, ArrayType(Byte))).
+		Results(Field("", nil, ErrorType)).
+		AppendStmt(
+			If(
+				Equal(ExpressionTypeConvert(data, String), StringConstant("null").Expr()),
+				Assign(VarNames{Star(m)}, Assignment, StructLiteral(typeName).Expr()),
+				Return(Nil),
+			),
+			IfInit(
+				Assign(VarNames{errVar}, Definition, Call(JsonUnmarshal, data, Ref(Selector(m, "value")))),
+				NotNil(errVar),
+				Return(errVar),
+			),
+			Assign(VarNames{Selector(m, "present")}, Assignment, True),
+			Return(Nil),
+		).
+		Decl()
+}
+
+// makeMaybeScan treats NULL as the omitted value
+//
+//	func (m *<name>) Scan(src interface{}) error {
+//	    switch val := src.(type) {
+//	    case nil:
+//	        *m = <name>{}
+//	    case <representation>:
+//	        m.Set(<valueType>(val))
+//	    default:
+//	        return fmt.Errorf("cannot scan %T into <name>", src)
+//	    }
+//	    return nil
+//	}
+func makeMaybeScan(typeName *ast.Ident, valueType, representation ast.Expr) ast.Decl {
+	var (
+		m     = ast.NewIdent("m")
+		src   = ast.NewIdent("src")
+		val   = ast.NewIdent("val")
+		cases = []SwitchCase{MakeSwitchCase(Nil).Body(Assign(VarNames{Star(m)}, Assignment, StructLiteral(typeName).Expr()))}
+	)
+	cases = append(cases, sqlScanCases(representation, val, func(x ast.Expr) ast.Stmt {
+		if representation != valueType {
+			x = ExpressionTypeConvert(x, valueType)
+		}
+		return CallStmt(Call(InlineFunc(Selector(m, "Set")), x))
+	})...)
+	cases = append(cases, MakeSwitchCase().Body(
+		Return(Call(FmtErrorfFn, StringConstant("cannot scan %T into "+typeName.Name).Expr(), src)),
+	))
+	return DeclareFunction(ast.NewIdent("Scan")).
+		Receiver(Field(m.Name, nil, Star(typeName))).
+		Params(Field(src.Name, nil, EmptyInterface)).
+		Results(Field("", nil, ErrorType)).
+		AppendStmt(
+			MakeTypeSwitch(Assign(VarNames{val}, Definition, ExpressionTypeAssert(src, nil)), cases...),
+			Return(Nil),
+		).
+		Decl()
+}
+
+func makeMaybeValue(typeName *ast.Ident, valueType, representation ast.Expr) ast.Decl {
+	var (
+		m     = ast.NewIdent("m")
+		value = Selector(m, "value")
+	)
+	if representation != valueType {
+		value = ExpressionTypeConvert(value, representation)
+	}
+	return DeclareFunction(ast.NewIdent("Value")).
+		Receiver(Field(m.Name, nil, typeName)).
+		Results(Field("", nil, DriverValue), Field("", nil, ErrorType)).
+		AppendStmt(
+			If(Not(Selector(m, "present")), Return(Nil, Nil)),
+			Return(value, Nil),
+		).
+		Decl()
+}
@@ -0,0 +1,116 @@
+package asthlp
+
+import (
+	"go/ast"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MakeSnakeToCamelFunc declares the function that converts snake_case to CamelCase at runtime
+//
+//	func <name>(s string) string {
+//	    var result = make([]rune, 0, len(s))
+//	    var upper = true
+//	    for _, r := range s {
+//	        if r == '_' {
+//	            upper = true
+//	            continue
+//	        }
+//	        if upper {
+//	            r = unicode.ToUpper(r)
+//	            upper = false
+//	        }
+//	        result = append(result, r)
+//	    }
+//	    return string(result)
+//	}
+func MakeSnakeToCamelFunc(name string) ast.Decl {
+	var (
+		s      = ast.NewIdent("s")
+		r      = ast.NewIdent("r")
+		upper  = ast.NewIdent("upper")
+		result = ast.NewIdent("result")
+	)
+	return DeclareFunction(ast.NewIdent(name)).
+		Comments("// "+name+" converts snake_case to CamelCase").
+		Params(Field(s.Name, nil, String)).
+		Results(Field("", nil, String)).
+		AppendStmt(
+			Var(VariableValue(result.Name, FreeExpression(Call(MakeFn, ArrayType(Rune), Zero, Call(LengthFn, s))))),
+			Var(VariableValue(upper.Name, FreeExpression(True))),
+			Range(true, "_", r.Name, s,
+				If(
+					Equal(r, RuneConstant('_').Expr()),
+					Assign(VarNames{upper}, Assignment, True),
+					Continue(),
+				),
+				If(
+					upper,
+					Assign(VarNames{r}, Assignment, Call(UnicodeToUpperFn, r)),
+					Assign(VarNames{upper}, Assignment, False),
+				),
+				Assign(VarNames{result}, Assignment, Call(AppendFn, result, r)),
+			),
+			Return(ExpressionTypeConvert(result, String)),
+		).
+		Decl()
+}
+
+// MakeCamelToSnakeFunc declares the function that converts CamelCase to snake_case at runtime
+//
+//	func <name>(s string) string {
+//	    var result = make([]rune, 0, len(s))
+//	    for i, r := range s {
+//	        if unicode.IsUpper(r) {
+//	            if i > 0 {
+//	                result = append(result, '_')
+//	            }
+//	            r = unicode.ToLower(r)
+//	        }
+//	        result = append(result, r)
+//	    }
+//	    return string(result)
+//	}
+func MakeCamelToSnakeFunc(name string) ast.Decl {
+	var (
+		s      = ast.NewIdent("s")
+		i      = ast.NewIdent("i")
+		r      = ast.NewIdent("r")
+		result = ast.NewIdent("result")
+	)
+	return DeclareFunction(ast.NewIdent(name)).
+		Comments("// "+name+" converts CamelCase to snake_case").
+		Params(Field(s.Name, nil, String)).
+		Results(Field("", nil, String)).
+		AppendStmt(
+			Var(VariableValue(result.Name, FreeExpression(Call(MakeFn, ArrayType(Rune), Zero, Call(LengthFn, s))))),
+			Range(true, i.Name, r.Name, s,
+				If(
+					Call(UnicodeIsUpperFn, r),
+					If(
+						Great(i, Zero),
+						Assign(VarNames{result}, Assignment, Call(AppendFn, result, RuneConstant('_').Expr())),
+					),
+					Assign(VarNames{r}, Assignment, Call(UnicodeToLowerFn, r)),
+				),
+				Assign(VarNames{result}, Assignment, Call(AppendFn, result, r)),
+			),
+			Return(ExpressionTypeConvert(result, String)),
+		).
+		Decl()
+}
+
+// camelCase converts snake_case to CamelCase at generation time
+func camelCase(s string) string {
+	var result strings.Builder
+	for _, part := range strings.Split(s, "_") {
+		if part == "" {
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(part)
+		result.WriteRune(unicode.ToUpper(r))
+		result.WriteString(part[size:])
+	}
+	return result.String()
+}
@@ -0,0 +1,511 @@
+package asthlp
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type (
+	// OpenApiSchema is the subset of the OpenAPI 3 schema object used to generate the types
+	OpenApiSchema struct {
+		Ref                  string                    `json:"$ref"`
+		Type                 string                    `json:"type"`
+		Format               string                    `json:"format"`
+		Description          string                    `json:"description"`
+		Nullable             bool                      `json:"nullable"`
+		Required             []string                  `json:"required"`
+		Properties           map[string]*OpenApiSchema `json:"properties"`
+		AdditionalProperties *OpenApiSchema            `json:"additionalProperties"`
+		Items                *OpenApiSchema            `json:"items"`
+		Enum                 []interface{}             `json:"enum"`
+		MinLength            *int64                    `json:"minLength"`
+		MaxLength            *int64                    `json:"maxLength"`
+		Minimum              *float64                  `json:"minimum"`
+		Maximum              *float64                  `json:"maximum"`
+		Pattern              string                    `json:"pattern"`
+	}
+	// OpenApiOptions describes the declarations created with MakeOpenApiTypes
+	OpenApiOptions struct {
+		// Validation adds the Validate method to each struct
+		Validation bool
+		// Binding adds the Decode<Name> function decoding the struct from the JSON reader,
+		// the decoded struct is validated if Validation is set
+		Binding bool
+	}
+)
+
+// ParseOpenApiSchemas reads the component schemas of the OpenAPI 3 document in JSON
+func ParseOpenApiSchemas(document []byte) (map[string]*OpenApiSchema, error) {
+	var doc struct {
+		Components struct {
+			Schemas map[string]*OpenApiSchema `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(document, &doc); err != nil {
+		return nil, fmt.Errorf("cannot parse OpenAPI document: %w", err)
+	}
+	return doc.Components.Schemas, nil
+}
+
+// MakeOpenApiTypes declares the types of the component schemas sorted by name, objects become structs
+// with json and validate tags, the other schemas become named types of the Go equivalent.
+// Optional and nullable properties become pointers unless they are slices or maps
+//
+//	type <Name> struct {
+//	    // Id is the identifier of the user
+//	    Id    int64   `json:"id" validate:"required,gte=1"`
+//	    Email *string `json:"email,omitempty" validate:"omitempty,max=255"`
+//	}
+func MakeOpenApiTypes(schemas map[string]*OpenApiSchema, opts OpenApiOptions) []ast.Decl {
+	var (
+		names    = make([]string, 0, len(schemas))
+		decls    []ast.Decl
+		patterns []RegexpPattern
+		methods  []ast.Decl
+	)
+	for name := range schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		var (
+			schema   = schemas[name]
+			typeName = openApiName(name)
+		)
+		if !schema.isStruct() {
+			decls = append(decls, &ast.GenDecl{
+				Doc:   CommentGroup(openApiComment(typeName, schema.Description)...),
+				Tok:   token.TYPE,
+				Specs: []ast.Spec{TypeSpec(typeName, openApiType(schema))},
+			})
+			continue
+		}
+		filler := StructTypeFiller(typeName)
+		for _, prop := range schema.propertyNames() {
+			var field = schema.Properties[prop]
+			filler.Field(
+				openApiName(prop),
+				MakeTagsForField(field.tags(prop, schema.isRequired(prop))),
+				openApiFieldType(field, schema.isRequired(prop)),
+				openApiComment("", field.Description)...,
+			)
+		}
+		decls = append(decls, &ast.GenDecl{
+			Doc:   CommentGroup(openApiComment(typeName, schema.Description)...),
+			Tok:   token.TYPE,
+			Specs: []ast.Spec{filler.TypeSpec()},
+		})
+		if opts.Validation {
+			method, typePatterns := MakeOpenApiValidate(typeName, schema, schemas)
+			methods = append(methods, method)
+			patterns = append(patterns, typePatterns...)
+		}
+		if opts.Binding {
+			methods = append(methods, MakeOpenApiDecode(typeName, opts.Validation))
+		}
+	}
+	if len(patterns) > 0 {
+		patternsDecl, _ := MakeRegexpVars(patterns...)
+		decls = append(decls, patternsDecl)
+	}
+	return append(decls, methods...)
+}
+
+// MakeOpenApiValidate creates the Validate method of the struct checking the constraints of the schema,
+// the patterns to declare with MakeRegexpVars are returned along with the method.
+// The required properties that are not nullable must not be zero the way the `required` tag of the validator checks
+// them, e.g. nil slices and maps or empty strings are refused. Structs and times are not checked for zero,
+// the referenced structs are validated with their own Validate method
+//
+//	func (v *<name>) Validate() error {
+//	    if v.Email == "" {
+//	        return errors.New("email: is required")
+//	    }
+//	    if utf8.RuneCountInString(v.Email) > 255 {
+//	        return errors.New("email: length must be at most 255")
+//	    }
+//	    if !userEmailPattern.MatchString(v.Email) {
+//	        return errors.New("email: must match the pattern")
+//	    }
+//	    if v.Tags == nil {
+//	        return errors.New("tags: is required")
+//	    }
+//	    if err := v.Address.Validate(); err != nil {
+//	        return fmt.Errorf("address: %w", err)
+//	    }
+//	    return nil
+//	}
+func MakeOpenApiValidate(name string, schema *OpenApiSchema, schemas map[string]*OpenApiSchema) (ast.Decl, []RegexpPattern) {
+	var (
+		v        = ast.NewIdent("v")
+		errVar   = ast.NewIdent("err")
+		patterns []RegexpPattern
+		fn       = DeclareFunction(ast.NewIdent("Validate")).
+				Receiver(Field(v.Name, nil, Star(ast.NewIdent(name)))).
+				Results(Field("", nil, ErrorType))
+	)
+	var fail = func(prop, message string) ast.Stmt {
+		return Return(Call(ErrorsNewFn, StringConstant(prop+": "+message).Expr()))
+	}
+	for _, prop := range schema.propertyNames() {
+		var (
+			field    = schema.Properties[prop]
+			value    = Selector(v, openApiName(prop))
+			pointer  = field.isPointer(schema.isRequired(prop))
+			required = schema.isRequired(prop) && !pointer && !field.Nullable
+			checks   []ast.Stmt
+		)
+		if pointer {
+			value = Star(value)
+		}
+		if field.MinLength != nil {
+			checks = append(checks, If(
+				Binary(field.length(value), IntegerConstant(*field.MinLength).Expr(), token.LSS),
+				fail(prop, fmt.Sprintf("length must be at least %d", *field.MinLength)),
+			))
+		}
+		if field.MaxLength != nil {
+			checks = append(checks, If(
+				Great(field.length(value), IntegerConstant(*field.MaxLength).Expr()),
+				fail(prop, fmt.Sprintf("length must be at most %d", *field.MaxLength)),
+			))
+		}
+		if field.Minimum != nil {
+			checks = append(checks, If(
+				Binary(value, field.number(*field.Minimum), token.LSS),
+				fail(prop, "must be at least "+formatOpenApiNumber(*field.Minimum)),
+			))
+		}
+		if field.Maximum != nil {
+			checks = append(checks, If(
+				Great(value, field.number(*field.Maximum)),
+				fail(prop, "must be at most "+formatOpenApiNumber(*field.Maximum)),
+			))
+		}
+		if field.Pattern != "" {
+			pattern := RegexpPattern{Name: openApiVarName(name) + openApiName(prop) + "Pattern", Pattern: field.Pattern}
+			patterns = append(patterns, pattern)
+			checks = append(checks, If(
+				Not(Call(RegexpMatchStringFn(ast.NewIdent(pattern.Name)), value)),
+				fail(prop, "must match the pattern"),
+			))
+		}
+		if len(field.Enum) > 0 && field.isScalar() {
+			var (
+				conditions = make([]ast.Expr, 0, len(field.Enum))
+				values     = make([]string, 0, len(field.Enum))
+			)
+			for _, item := range field.Enum {
+				conditions = append(conditions, NotEqual(value, field.constant(item)))
+				values = append(values, formatOpenApiValue(item))
+			}
+			checks = append(checks, If(
+				And(conditions[0], conditions[1:]...),
+				fail(prop, "must be one of "+strings.Join(values, ", ")),
+			))
+		}
+		if target := field.refName(); target != "" && schemas[target] != nil && schemas[target].isStruct() {
+			checks = append(checks, IfInit(
+				Assign(VarNames{errVar}, Definition, Call(InlineFunc(Selector(Selector(v, openApiName(prop)), "Validate")))),
+				NotNil(errVar),
+				Return(Call(FmtErrorfFn, StringConstant(prop+": %w").Expr(), errVar)),
+			))
+		}
+		if absent := field.absent(value, schemas); required && absent != nil {
+			fn.AppendStmt(If(absent, fail(prop, "is required")))
+		}
+		if len(checks) == 0 {
+			continue
+		}
+		if pointer {
+			fn.AppendStmt(If(NotNil(Selector(v, openApiName(prop))), checks...))
+			continue
+		}
+		fn.AppendStmt(checks...)
+	}
+	return fn.AppendStmt(Return(Nil)).Decl(), patterns
+}
+
+// MakeOpenApiDecode creates the function decoding the struct from the JSON reader
+//
+//	func Decode<name>(r io.Reader) (*<name>, error) {
+//	    var v <name>
+//	    if err := json.NewDecoder(r).Decode(&v); err != nil {
+//	        return nil, err
+//	    }
+//	    if err := v.Validate(); err != nil {
+//	        return nil, err
+//	    }
+//	    return &v, nil
+//	}
+func MakeOpenApiDecode(name string, validate bool) ast.Decl {
+	var (
+		r      = ast.NewIdent("r")
+		v      = ast.NewIdent("v")
+		errVar = ast.NewIdent("err")
+		fn     = DeclareFunction(ast.NewIdent("Decode"+name)).
+			Params(Field(r.Name, nil, IoReader)).
+			Results(
+				Field("", nil, Star(ast.NewIdent(name))),
+				Field("", nil, ErrorType),
+			)
+	)
+	fn.AppendStmt(
+		Var(VariableType(v.Name, ast.NewIdent(name))),
+		IfInit(
+			Assign(VarNames{errVar}, Definition, MakeJsonDecode(r, Ref(v))),
+			NotNil(errVar),
+			Return(Nil, errVar),
+		),
+	)
+	if validate {
+		fn.AppendStmt(IfInit(
+			Assign(VarNames{errVar}, Definition, Call(InlineFunc(Selector(v, "Validate")))),
+			NotNil(errVar),
+			Return(Nil, errVar),
+		))
+	}
+	return fn.AppendStmt(Return(Ref(v), Nil)).Decl()
+}
+
+func (s *OpenApiSchema) isStruct() bool {
+	return s.Ref == "" && (s.Type == "object" || s.Type == "") && len(s.Properties) > 0
+}
+
+func (s *OpenApiSchema) isRequired(prop string) bool {
+	for _, required := range s.Required {
+		if required == prop {
+			return true
+		}
+	}
+	return false
+}
+
+// isPointer reports whether the field can be absent, slices and maps stay as they are
+func (s *OpenApiSchema) isPointer(required bool) bool {
+	if s.Type == "array" || (s.Type == "object" && !s.isStruct()) {
+		return false
+	}
+	return s.Nullable || !required
+}
+
+func (s *OpenApiSchema) propertyNames() []string {
+	var names = make([]string, 0, len(s.Properties))
+	for name := range s.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (s *OpenApiSchema) refName() string {
+	if s.Ref == "" {
+		return ""
+	}
+	return s.Ref[strings.LastIndex(s.Ref, "/")+1:]
+}
+
+// isScalar reports whether the schema is the string, the number or the boolean of the basic Go type
+func (s *OpenApiSchema) isScalar() bool {
+	_, ok := openApiType(s).(*ast.Ident)
+	return ok && s.Ref == "" && s.Type != ""
+}
+
+// constant creates the constant of the enum value of the scalar schema
+func (s *OpenApiSchema) constant(value interface{}) ast.Expr {
+	switch v := value.(type) {
+	case string:
+		if s.Type == "string" {
+			return StringConstant(v).Expr()
+		}
+	case float64:
+		if s.Type == "integer" || s.Type == "number" {
+			return s.number(v)
+		}
+	case bool:
+		if s.Type == "boolean" {
+			return ast.NewIdent(strconv.FormatBool(v))
+		}
+	}
+	panic(fmt.Sprintf("the enum value %v does not match the type %s", value, s.Type))
+}
+
+// absent creates the condition reporting that the value is zero the way the `required` tag of the validator checks it,
+// nil is returned for the structs and the times
+func (s *OpenApiSchema) absent(value ast.Expr, schemas map[string]*OpenApiSchema) ast.Expr {
+	if target := s.refName(); target != "" {
+		if ref := schemas[target]; ref != nil && !ref.isStruct() {
+			return ref.absent(value, schemas)
+		}
+		return nil
+	}
+	switch s.Type {
+	case "string":
+		switch s.Format {
+		case "date-time", "date":
+			return nil
+		case "uuid":
+			return Equal(value, UuidNil)
+		case "byte", "binary":
+			return IsNil(value)
+		}
+		return Equal(value, EmptyString)
+	case "integer", "number":
+		return Equal(value, IntegerConstant(0).Expr())
+	case "boolean":
+		return Not(value)
+	}
+	if s.isStruct() {
+		return nil
+	}
+	return IsNil(value)
+}
+
+// length creates the length of the value, the length of the string is the count of characters as in the JSON schema
+func (s *OpenApiSchema) length(value ast.Expr) ast.Expr {
+	if s.Type == "string" && s.Format != "byte" && s.Format != "binary" {
+		return Call(Utf8RuneCountInStringFn, value)
+	}
+	return Call(LengthFn, value)
+}
+
+// number creates the constant of the type of the schema
+func (s *OpenApiSchema) number(n float64) ast.Expr {
+	if s.Type == "integer" {
+		return IntegerConstant(int64(n)).Expr()
+	}
+	return &ast.BasicLit{Kind: token.FLOAT, Value: formatOpenApiNumber(n)}
+}
+
+func (s *OpenApiSchema) tags(prop string, required bool) map[string][]string {
+	var (
+		jsonTag     = []string{prop}
+		validateTag []string
+	)
+	// the validator supports oneof for strings and integers only
+	var oneOf = len(s.Enum) > 0 && s.isScalar() && (s.Type == "string" || s.Type == "integer")
+	if !required {
+		jsonTag = append(jsonTag, "omitempty")
+	}
+	if required && !s.Nullable {
+		validateTag = append(validateTag, "required")
+	} else if s.MinLength != nil || s.MaxLength != nil || s.Minimum != nil || s.Maximum != nil || oneOf {
+		validateTag = append(validateTag, "omitempty")
+	}
+	if s.MinLength != nil {
+		validateTag = append(validateTag, fmt.Sprintf("min=%d", *s.MinLength))
+	}
+	if s.MaxLength != nil {
+		validateTag = append(validateTag, fmt.Sprintf("max=%d", *s.MaxLength))
+	}
+	if s.Minimum != nil {
+		validateTag = append(validateTag, "gte="+formatOpenApiNumber(*s.Minimum))
+	}
+	if s.Maximum != nil {
+		validateTag = append(validateTag, "lte="+formatOpenApiNumber(*s.Maximum))
+	}
+	if oneOf {
+		var values = make([]string, 0, len(s.Enum))
+		for _, item := range s.Enum {
+			values = append(values, formatOpenApiValue(item))
+		}
+		validateTag = append(validateTag, "oneof="+strings.Join(values, " "))
+	}
+	return map[string][]string{"json": jsonTag, "validate": validateTag}
+}
+
+func openApiFieldType(s *OpenApiSchema, required bool) ast.Expr {
+	if s.isPointer(required) {
+		return Star(openApiType(s))
+	}
+	return openApiType(s)
+}
+
+// openApiType converts the schema to the Go type, the inline objects become anonymous structs
+func openApiType(s *OpenApiSchema) ast.Expr {
+	if s.Ref != "" {
+		return ast.NewIdent(openApiName(s.refName()))
+	}
+	switch s.Type {
+	case "string":
+		switch s.Format {
+		case "date-time", "date":
+			return TimeTime
+		case "uuid":
+			return UUID
+		case "byte", "binary":
+			return ArrayType(Byte)
+		}
+		return String
+	case "integer":
+		if s.Format == "int32" {
+			return Int32
+		}
+		return Int64
+	case "number":
+		if s.Format == "float" {
+			return Float32
+		}
+		return Float64
+	case "boolean":
+		return Bool
+	case "array":
+		if s.Items == nil {
+			return ArrayType(EmptyInterface)
+		}
+		return ArrayType(openApiType(s.Items))
+	}
+	if s.isStruct() {
+		var fields = make([]*ast.Field, 0, len(s.Properties))
+		for _, prop := range s.propertyNames() {
+			var field = s.Properties[prop]
+			fields = append(fields, Field(
+				openApiName(prop),
+				MakeTagsForField(field.tags(prop, s.isRequired(prop))),
+				openApiFieldType(field, s.isRequired(prop)),
+			))
+		}
+		return StructType(fields...)
+	}
+	if s.AdditionalProperties != nil {
+		return MapType(String, openApiType(s.AdditionalProperties))
+	}
+	return MapType(String, EmptyInterface)
+}
+
+// openApiName converts the name of the schema or the property to the exported Go name
+func openApiName(name string) string {
+	return camelCase(strings.NewReplacer("-", "_", ".", "_", " ", "_").Replace(name))
+}
+
+// openApiVarName converts the name to the unexported Go name
+func openApiVarName(name string) string {
+	return strings.ToLower(name[:1]) + name[1:]
+}
+
+// openApiComment prefixes the description with the name, as the fields are prefixed by the Field itself
+func openApiComment(name, description string) []string {
+	if description == "" {
+		return nil
+	}
+	if name == "" {
+		return []string{description}
+	}
+	return []string{name + " " + description}
+}
+
+func formatOpenApiNumber(n float64) string {
+	return strconv.FormatFloat(n, 'f', -1, 64)
+}
+
+func formatOpenApiValue(value interface{}) string {
+	if n, ok := value.(float64); ok {
+		return formatOpenApiNumber(n)
+	}
+	return fmt.Sprint(value)
+}
@@ -0,0 +1,75 @@
+package asthlp
+
+import (
+	"testing"
+
+	"github.com/iv-menshenin/go-ast/explorer"
+)
+
+func TestMakeOpenApiValidate(t *testing.T) {
+	var maxLength int64 = 10
+	var schema = &OpenApiSchema{
+		Type:     "object",
+		Required: []string{"name", "note", "tags"},
+		Properties: map[string]*OpenApiSchema{
+			"name": {Type: "string", MaxLength: &maxLength},
+			"note": {Type: "string", Nullable: true},
+			"tags": {Type: "array", Items: &OpenApiSchema{Type: "string"}},
+		},
+	}
+	decl, _ := MakeOpenApiValidate("Item", schema, map[string]*OpenApiSchema{"Item": schema})
+	assertRendered(
+		t,
+		DeclareFile("p").AppendDecl(decl).File(),
+		"package p\n\nfunc (v *Item) Validate() error {\n"+
+			"\tif v.Name == \"\" {\n\t\treturn errors.New(\"name: is required\")\n\t}\n"+
+			"\tif utf8.RuneCountInString(v.Name) > 10 {\n\t\treturn errors.New(\"name: length must be at most 10\")\n\t}\n"+
+			"\tif v.Tags == nil {\n\t\treturn errors.New(\"tags: is required\")\n\t}\n"+
+			"\treturn nil\n}\n",
+	)
+}
+
+const openApiDocument = `{"components": {"schemas": {
+	"Address": {
+		"type": "object",
+		"required": ["city"],
+		"properties": {
+			"city": {"type": "string", "maxLength": 64},
+			"zip": {"type": "string", "pattern": "^[0-9]{5}$"}
+		}
+	},
+	"Status": {"type": "string", "enum": ["active", "blocked"]},
+	"User": {
+		"type": "object",
+		"description": "is the registered user",
+		"required": ["id", "uid", "email", "address", "tags", "status", "verified", "note"],
+		"properties": {
+			"id": {"type": "integer", "minimum": 1},
+			"uid": {"type": "string", "format": "uuid"},
+			"email": {"type": "string", "maxLength": 255},
+			"address": {"$ref": "#/components/schemas/Address"},
+			"tags": {"type": "array", "items": {"type": "string"}},
+			"status": {"$ref": "#/components/schemas/Status"},
+			"verified": {"type": "boolean"},
+			"note": {"type": "string", "nullable": true, "minLength": 1},
+			"score": {"type": "number", "enum": [0.5, 1]},
+			"level": {"type": "integer", "format": "int32", "enum": [1, 2, 3]},
+			"created": {"type": "string", "format": "date-time"},
+			"attributes": {"type": "object", "additionalProperties": {"type": "string"}}
+		}
+	}
+}}}`
+
+func TestMakeOpenApiTypes_TypeCheck(t *testing.T) {
+	schemas, err := ParseOpenApiSchemas([]byte(openApiDocument))
+	if err != nil {
+		t.Fatal(err)
+	}
+	typeCheck(
+		t,
+		explorer.New(),
+		map[string]string{"github.com/google/uuid": "package uuid\n\ntype UUID [16]byte\n\nvar Nil UUID\n"},
+		"package p\n",
+		MakeOpenApiTypes(schemas, OpenApiOptions{Validation: true, Binding: true})...,
+	)
+}
@@ -0,0 +1,144 @@
+package asthlp
+
+import "go/ast"
+
+var (
+	// BcryptDefaultCost represents the `bcrypt.DefaultCost` constant of golang.org/x/crypto/bcrypt
+	BcryptDefaultCost = SimpleSelector("bcrypt", "DefaultCost")
+
+	// BcryptGenerateFromPasswordFn is a construction of the `bcrypt.GenerateFromPassword` function
+	BcryptGenerateFromPasswordFn = makeFunc(SimpleSelector("bcrypt", "GenerateFromPassword"), 2, false)
+	// BcryptCompareHashAndPasswordFn is a construction of the `bcrypt.CompareHashAndPassword` function
+	BcryptCompareHashAndPasswordFn = makeFunc(SimpleSelector("bcrypt", "CompareHashAndPassword"), 2, false)
+	// CrandReadFn is a construction of the `rand.Read` function of crypto/rand, imported as crand
+	CrandReadFn = makeFunc(SimpleSelector("crand", "Read"), 1, false)
+)
+
+type (
+	// PasswordHash defines the algorithm of the functions created with MakeEncryptPasswordFunc and MakeCheckPasswordFunc
+	PasswordHash int8
+)
+
+const (
+	// PasswordHashBcrypt hashes the password with bcrypt of golang.org/x/crypto, the passwords longer than 72 bytes are refused
+	PasswordHashBcrypt PasswordHash = iota
+	// PasswordHashSha256 hashes the random salt followed by the password with a single round of sha256, the result is
+	// `<salt hex>:<sum hex>`. It is not a key derivation function and is fast to brute force, use it only to stay
+	// compatible with the hashes already stored this way
+	PasswordHashSha256
+)
+
+// MakeEncryptPasswordFunc declares the default implementation of the function hashing the password,
+// the function returns the error if the password cannot be hashed, e.g. bcrypt refuses the passwords longer than 72 bytes
+//
+//	func encryptPassword(password string) (string, error) {
+//	    hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+//	    if err != nil {
+//	        return "", err
+//	    }
+//	    return string(hash), nil
+//	}
+func MakeEncryptPasswordFunc(name string, algorithm PasswordHash) ast.Decl {
+	var (
+		password = ast.NewIdent("password")
+		hash     = ast.NewIdent("hash")
+		salt     = ast.NewIdent("salt")
+		h        = ast.NewIdent("h")
+		errVar   = ast.NewIdent("err")
+		fn       = DeclareFunction(ast.NewIdent(name)).
+				Params(Field(password.Name, nil, String)).
+				Results(Field("", nil, String), Field("", nil, ErrorType))
+	)
+	switch algorithm {
+	case PasswordHashBcrypt:
+		return fn.AppendStmt(
+			Assign(VarNames{hash, errVar}, Definition, Call(
+				BcryptGenerateFromPasswordFn,
+				ExpressionTypeConvert(password, ArrayType(Byte)),
+				BcryptDefaultCost,
+			)),
+			If(NotNil(errVar), Return(EmptyString, errVar)),
+			Return(ExpressionTypeConvert(hash, String), Nil),
+		).Decl()
+	case PasswordHashSha256:
+		return fn.AppendStmt(
+			Assign(VarNames{salt}, Definition, Call(MakeFn, ArrayType(Byte), IntegerConstant(16).Expr())),
+			IfInit(
+				Assign(VarNames{Blank, errVar}, Definition, Call(CrandReadFn, salt)),
+				NotNil(errVar),
+				Return(EmptyString, errVar),
+			),
+			Assign(VarNames{h}, Definition, Call(Sha256NewFn)),
+			CallStmt(Call(HashWriteFn(h), salt)),
+			CallStmt(Call(HashWriteFn(h), ExpressionTypeConvert(password, ArrayType(Byte)))),
+			Return(
+				Add(
+					Call(HexEncodeToStringFn, salt),
+					StringConstant(":").Expr(),
+					Call(HexEncodeToStringFn, Call(HashSumFn(h), Nil)),
+				),
+				Nil,
+			),
+		).Decl()
+	default:
+		panic("unknown password hash algorithm")
+	}
+}
+
+// MakeCheckPasswordFunc declares the function reporting whether the password matches the hash
+// created by the function of MakeEncryptPasswordFunc with the same algorithm
+//
+//	func checkPassword(hash string, password string) bool {
+//	    saltHex, sumHex, ok := strings.Cut(hash, ":")
+//	    if !ok {
+//	        return false
+//	    }
+//	    salt, err := hex.DecodeString(saltHex)
+//	    if err != nil {
+//	        return false
+//	    }
+//	    h := sha256.New()
+//	    h.Write(salt)
+//	    h.Write([]byte(password))
+//	    return hmac.Equal([]byte(sumHex), []byte(hex.EncodeToString(h.Sum(nil))))
+//	}
+func MakeCheckPasswordFunc(name string, algorithm PasswordHash) ast.Decl {
+	var (
+		hash     = ast.NewIdent("hash")
+		password = ast.NewIdent("password")
+		saltHex  = ast.NewIdent("saltHex")
+		sumHex   = ast.NewIdent("sumHex")
+		ok       = ast.NewIdent("ok")
+		salt     = ast.NewIdent("salt")
+		h        = ast.NewIdent("h")
+		errVar   = ast.NewIdent("err")
+		fn       = DeclareFunction(ast.NewIdent(name)).
+				Params(Field(hash.Name, nil, String), Field(password.Name, nil, String)).
+				Results(Field("", nil, Bool))
+	)
+	switch algorithm {
+	case PasswordHashBcrypt:
+		return fn.AppendStmt(Return(IsNil(Call(
+			BcryptCompareHashAndPasswordFn,
+			ExpressionTypeConvert(hash, ArrayType(Byte)),
+			ExpressionTypeConvert(password, ArrayType(Byte)),
+		)))).Decl()
+	case PasswordHashSha256:
+		return fn.AppendStmt(
+			Assign(VarNames{saltHex, sumHex, ok}, Definition, Call(StringsCutFn, hash, StringConstant(":").Expr())),
+			If(Not(ok), Return(False)),
+			Assign(VarNames{salt, errVar}, Definition, Call(HexDecodeStringFn, saltHex)),
+			If(NotNil(errVar), Return(False)),
+			Assign(VarNames{h}, Definition, Call(Sha256NewFn)),
+			CallStmt(Call(HashWriteFn(h), salt)),
+			CallStmt(Call(HashWriteFn(h), ExpressionTypeConvert(password, ArrayType(Byte)))),
+			Return(Call(
+				HmacEqualFn,
+				ExpressionTypeConvert(sumHex, ArrayType(Byte)),
+				ExpressionTypeConvert(Call(HexEncodeToStringFn, Call(HashSumFn(h), Nil)), ArrayType(Byte)),
+			)),
+		).Decl()
+	default:
+		panic("unknown password hash algorithm")
+	}
+}
@@ -0,0 +1,64 @@
+package asthlp
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/iv-menshenin/go-ast/explorer"
+)
+
+func TestMakePasswordFuncs_Imports(t *testing.T) {
+	var tests = []struct {
+		name      string
+		algorithm PasswordHash
+		want      []string
+	}{
+		{
+			name:      "bcrypt",
+			algorithm: PasswordHashBcrypt,
+			want:      []string{"golang.org/x/crypto/bcrypt"},
+		},
+		{
+			name:      "sha256",
+			algorithm: PasswordHashSha256,
+			want:      []string{"crypto/hmac", "crypto/rand", "crypto/sha256", "encoding/hex", "strings"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var imports = importDecl(
+				explorer.New(),
+				MakeEncryptPasswordFunc("encryptPassword", tt.algorithm),
+				MakeCheckPasswordFunc("checkPassword", tt.algorithm),
+			)
+			if got := importPaths(t, imports); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("importPaths() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMakePasswordFuncs_TypeCheck(t *testing.T) {
+	var stubs = map[string]string{"golang.org/x/crypto/bcrypt": `package bcrypt
+
+const DefaultCost = 10
+
+func GenerateFromPassword(password []byte, cost int) ([]byte, error) {
+	return password, nil
+}
+
+func CompareHashAndPassword(hashedPassword, password []byte) error {
+	return nil
+}
+`}
+	for _, algorithm := range []PasswordHash{PasswordHashBcrypt, PasswordHashSha256} {
+		typeCheck(
+			t,
+			explorer.New(),
+			stubs,
+			"package p\n",
+			MakeEncryptPasswordFunc("encryptPassword", algorithm),
+			MakeCheckPasswordFunc("checkPassword", algorithm),
+		)
+	}
+}
@@ -0,0 +1,79 @@
+package asthlp
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+	"strings"
+)
+
+var (
+	// RegexpRegexp represents the `regexp.Regexp` struct
+	RegexpRegexp = SimpleSelector("regexp", "Regexp")
+
+	// RegexpMustCompileFn is a construction of the `regexp.MustCompile` function, the pattern must be a string literal
+	RegexpMustCompileFn = makeFunc(SimpleSelector("regexp", "MustCompile"), 1, false).WithArgumentKinds(ArgumentString)
+	// RegexpCompileFn is a construction of the `regexp.Compile` function
+	RegexpCompileFn = makeFunc(SimpleSelector("regexp", "Compile"), 1, false).WithArgumentKinds(ArgumentExpr)
+	// RegexpQuoteMetaFn is a construction of the `regexp.QuoteMeta` function
+	RegexpQuoteMetaFn = makeFunc(SimpleSelector("regexp", "QuoteMeta"), 1, false)
+)
+
+type (
+	// RegexpPattern describes the package-level variable declared with MakeRegexpVars
+	RegexpPattern struct {
+		Name    string
+		Pattern string
+	}
+)
+
+// RegexpMatchStringFn is a construction of the `<re>.MatchString` method of regexp.Regexp
+func RegexpMatchStringFn(re ast.Expr) CallFunctionDescriber {
+	return MethodOf(re, "MatchString", 1, false)
+}
+
+// RegexpFindStringSubmatchFn is a construction of the `<re>.FindStringSubmatch` method of regexp.Regexp
+func RegexpFindStringSubmatchFn(re ast.Expr) CallFunctionDescriber {
+	return MethodOf(re, "FindStringSubmatch", 1, false)
+}
+
+// RegexpFindAllStringFn is a construction of the `<re>.FindAllString` method of regexp.Regexp
+func RegexpFindAllStringFn(re ast.Expr) CallFunctionDescriber {
+	return MethodOf(re, "FindAllString", 2, false)
+}
+
+// RegexpReplaceAllStringFn is a construction of the `<re>.ReplaceAllString` method of regexp.Regexp
+func RegexpReplaceAllStringFn(re ast.Expr) CallFunctionDescriber {
+	return MethodOf(re, "ReplaceAllString", 2, false)
+}
+
+// MakeRegexpVars declares the package-level variables with compiled patterns and returns their idents in the same order
+//
+//	var (
+//	    <name1> = regexp.MustCompile(`<pattern1>`)
+//	    <name2> = regexp.MustCompile(`<pattern2>`)
+//	)
+func MakeRegexpVars(patterns ...RegexpPattern) (ast.Decl, []*ast.Ident) {
+	var (
+		decl   = DeclareVariable()
+		idents = make([]*ast.Ident, 0, len(patterns))
+	)
+	for _, pattern := range patterns {
+		spec := VariableValue(pattern.Name, FreeExpression(Call(RegexpMustCompileFn, regexpLiteral(pattern.Pattern))))
+		decl.AppendSpec(spec)
+		idents = append(idents, spec.Names[0])
+	}
+	return decl.Decl(), idents
+}
+
+// regexpLiteral prefers raw strings, so that backslashes of the pattern stay as they are
+func regexpLiteral(pattern string) ast.Expr {
+	var value = "`" + pattern + "`"
+	if strings.Contains(pattern, "`") {
+		value = strconv.Quote(pattern)
+	}
+	return &ast.BasicLit{
+		Kind:  token.STRING,
+		Value: value,
+	}
+}
@@ -0,0 +1,107 @@
+package asthlp
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/types"
+	"strings"
+	"sync"
+
+	"github.com/iv-menshenin/go-ast/explorer"
+)
+
+type (
+	// FuncRegistry creates describers of package functions on demand.
+	// When the package can be imported, the number of arguments is taken from the function signature,
+	// otherwise the describer accepts any number of arguments
+	FuncRegistry struct {
+		mux        sync.Mutex
+		importer   types.Importer
+		packages   map[string]*types.Package
+		describers map[string]CallFunctionDescriber
+		refs       *explorer.PackageRefs
+	}
+)
+
+// DefaultFuncRegistry resolves signatures from the compiled packages
+var DefaultFuncRegistry = NewFuncRegistry(importer.Default())
+
+// NewFuncRegistry creates the registry, the importer can be nil if signatures are not available
+func NewFuncRegistry(imp types.Importer) *FuncRegistry {
+	return &FuncRegistry{
+		importer:   imp,
+		packages:   make(map[string]*types.Package),
+		describers: make(map[string]CallFunctionDescriber),
+		refs:       explorer.NewPackageRefs(),
+	}
+}
+
+// Lookup returns the describer of the function from the package with the import path.
+// The package is referred to by the alias of the known package with that path, by its name otherwise
+// (see explorer.PackageRefs), so the discoverer of the file must be bound with Bind
+//
+//	Call(DefaultFuncRegistry.Lookup("crypto/rand", "Read"), ast.NewIdent("b")) // crand.Read(b)
+func (r *FuncRegistry) Lookup(path, name string) CallFunctionDescriber {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	var key = path + "." + name
+	if fn, ok := r.describers[key]; ok {
+		return fn
+	}
+	var (
+		pkg     = r.importPackage(path)
+		pkgName = path[strings.LastIndex(path, "/")+1:]
+	)
+	if pkg != nil {
+		pkgName = pkg.Name()
+	}
+	var (
+		ref = r.refs.Ident(explorer.Package{Path: path, Kind: packageKind(path)}, pkgName)
+		fn  = InlineFunc(Selector(ref, name))
+	)
+	if pkg != nil {
+		if f, ok := pkg.Scope().Lookup(name).(*types.Func); ok {
+			fn = signatureFunc(fn.FunctionName, f.Type().(*types.Signature))
+		}
+	}
+	r.describers[key] = fn
+	return fn
+}
+
+// Bind attributes the package identifiers of the describers created so far to their import paths in the discoverer
+//
+//	DefaultFuncRegistry.Bind(discoverer)
+//	discoverer.Explore(file)
+func (r *FuncRegistry) Bind(d *explorer.Discoverer) {
+	r.refs.Bind(d)
+}
+
+func (r *FuncRegistry) importPackage(path string) *types.Package {
+	if pkg, ok := r.packages[path]; ok {
+		return pkg
+	}
+	var pkg *types.Package
+	if r.importer != nil {
+		if p, err := r.importer.Import(path); err == nil {
+			pkg = p
+		}
+	}
+	r.packages[path] = pkg
+	return pkg
+}
+
+func signatureFunc(f ast.Expr, sig *types.Signature) CallFunctionDescriber {
+	var params = sig.Params().Len()
+	if sig.Variadic() {
+		return makeFunc(f, params-1, true)
+	}
+	return makeFunc(f, params, false)
+}
+
+// packageKind treats packages without a domain in the first element of the path as system ones
+func packageKind(path string) explorer.PkgKind {
+	if strings.Contains(strings.Split(path, "/")[0], ".") {
+		return explorer.PkgKindExternal
+	}
+	return explorer.PkgKindSystem
+}
@@ -0,0 +1,65 @@
+package asthlp
+
+import "go/ast"
+
+var (
+	// SlogLogger represents the `slog.Logger` struct
+	SlogLogger = SimpleSelector("slog", "Logger")
+
+	// SlogDebugFn is a construction of the `slog.Debug` function
+	SlogDebugFn = makeFunc(SimpleSelector("slog", "Debug"), 1, true)
+	// SlogInfoFn is a construction of the `slog.Info` function
+	SlogInfoFn = makeFunc(SimpleSelector("slog", "Info"), 1, true)
+	// SlogWarnFn is a construction of the `slog.Warn` function
+	SlogWarnFn = makeFunc(SimpleSelector("slog", "Warn"), 1, true)
+	// SlogErrorFn is a construction of the `slog.Error` function
+	SlogErrorFn = makeFunc(SimpleSelector("slog", "Error"), 1, true)
+	// SlogWithFn is a construction of the `slog.With` function
+	SlogWithFn = makeFunc(SimpleSelector("slog", "With"), 0, true)
+)
+
+type (
+	// KV is the key and the value of the structured logging attribute
+	KV struct {
+		Key   string
+		Value ast.Expr
+	}
+	// LogLevel selects the slog function of the call created with MakeLogCall
+	LogLevel int8
+)
+
+const (
+	LogDebug LogLevel = iota + 1
+	LogInfo
+	LogWarn
+	LogError
+)
+
+func (l LogLevel) fn() CallFunctionDescriber {
+	switch l {
+	case LogDebug:
+		return SlogDebugFn
+	case LogInfo:
+		return SlogInfoFn
+	case LogWarn:
+		return SlogWarnFn
+	case LogError:
+		return SlogErrorFn
+	default:
+		panic("unknown log level")
+	}
+}
+
+// MakeLogCall creates the structured logging call statement, attributes with nil values will be excluded
+//
+//	slog.Info("<msg>", "<key1>", <value1>, "<key2>", <value2>)
+func MakeLogCall(level LogLevel, msg string, kv ...KV) ast.Stmt {
+	var args = make([]ast.Expr, 0, len(kv)*2+1)
+	args = append(args, StringConstant(msg).Expr())
+	for _, attr := range kv {
+		if attr.Value != nil {
+			args = append(args, StringConstant(attr.Key).Expr(), attr.Value)
+		}
+	}
+	return CallStmt(Call(level.fn(), args...))
+}
@@ -0,0 +1,140 @@
+package asthlp
+
+import (
+	"fmt"
+	"go/ast"
+	"strings"
+)
+
+// MakeRunInTransactionFunc declares the function that runs the callback in the transaction
+//
+//	func <name>(ctx context.Context, db *sql.DB, fn func(tx *sql.Tx) error) (err error) {
+//	    tx, err := db.BeginTx(ctx, nil)
+//	    if err != nil {
+//	        return err
+//	    }
+//	    defer func() {
+//	        if p := recover(); p != nil {
+//	            _ = tx.Rollback()
+//	            panic(p)
+//	        }
+//	        if err != nil {
+//	            _ = tx.Rollback()
+//	            return
+//	        }
+//	        err = tx.Commit()
+//	    }()
+//	    return fn(tx)
+//	}
+func MakeRunInTransactionFunc(name string) ast.Decl {
+	var (
+		ctx = ast.NewIdent("ctx")
+		db  = ast.NewIdent("db")
+		fn  = ast.NewIdent("fn")
+		tx  = ast.NewIdent("tx")
+		err = ast.NewIdent("err")
+		p   = ast.NewIdent("p")
+	)
+	var fnType = DeclareMethod(fn).
+		Params(Field(tx.Name, nil, Star(SqlTx))).
+		Results(Field("", nil, ErrorType)).
+		Type()
+	var finalizer = DeclareFunction(nil).
+		AppendStmt(
+			IfInit(
+				Assign(VarNames{p}, Definition, Call(RecoverFn)),
+				NotNil(p),
+				Assign(VarNames{Blank}, Assignment, Call(TxRollbackMethod(tx))),
+				CallStmt(Call(PanicFn, p)),
+			),
+			If(
+				NotNil(err),
+				Assign(VarNames{Blank}, Assignment, Call(TxRollbackMethod(tx))),
+				ReturnEmpty(),
+			),
+			Assign(VarNames{err}, Assignment, Call(TxCommitMethod(tx))),
+		).
+		Lit()
+	return DeclareFunction(ast.NewIdent(name)).
+		Comments("// "+name+" runs fn in the transaction, commits it if fn succeeds and rolls it back otherwise").
+		Params(
+			Field(ctx.Name, nil, ContextType),
+			Field(db.Name, nil, Star(SqlDB)),
+			Field(fn.Name, nil, fnType),
+		).
+		Results(Field(err.Name, nil, ErrorType)).
+		AppendStmt(
+			Assign(VarNames{tx, err}, Definition, Call(DbBeginTxMethod(db), ctx, Nil)),
+			If(NotNil(err), Return(err)),
+			DeferCall(InlineFunc(finalizer)),
+			Return(Call(InlineFunc(fn), tx)),
+		).
+		Decl()
+}
+
+type (
+	// TableColumn describes the column of the table as it is presented in information_schema.columns
+	TableColumn struct {
+		Name     string
+		DataType string
+		Nullable bool
+		// Default is the default value expression of the column, nil if there is no default
+		Default *string
+	}
+	// ColumnTypeMapping maps the lowercase data type of the column to the Go type
+	ColumnTypeMapping map[string]ast.Expr
+)
+
+// DefaultColumnTypeMapping contains the Postgres data types, copy it and replace the values to change the mapping,
+// e.g. map "numeric" to the decimal type
+var DefaultColumnTypeMapping = ColumnTypeMapping{
+	"smallint":                    Int16,
+	"integer":                     Int32,
+	"bigint":                      Int64,
+	"smallserial":                 Int16,
+	"serial":                      Int32,
+	"bigserial":                   Int64,
+	"real":                        Float32,
+	"double precision":            Float64,
+	"numeric":                     Float64,
+	"boolean":                     Bool,
+	"text":                        String,
+	"character varying":           String,
+	"character":                   String,
+	"uuid":                        UUID,
+	"date":                        TimeTime,
+	"timestamp without time zone": TimeTime,
+	"timestamp with time zone":    TimeTime,
+	"bytea":                       ArrayType(Byte),
+	"json":                        SimpleSelector("json", "RawMessage"),
+	"jsonb":                       SimpleSelector("json", "RawMessage"),
+}
+
+// MakeTableStruct creates the row struct of the table, each field is tagged with the column name
+//
+//	type <name> struct {
+//	    // Id default nextval('table_id_seq'::regclass)
+//	    Id   int64   `sql:"id"`
+//	    Name *string `sql:"name"`
+//	}
+//
+// nullable columns are represented with pointers, returns an error if the data type of the column is not mapped
+func MakeTableStruct(name string, columns []TableColumn, mapping ColumnTypeMapping) (*ast.TypeSpec, error) {
+	var filler = StructTypeFiller(name)
+	for _, column := range columns {
+		fieldType, ok := mapping[strings.ToLower(column.DataType)]
+		if !ok {
+			return nil, fmt.Errorf("column %s: unknown data type %s", column.Name, column.DataType)
+		}
+		if column.Nullable {
+			fieldType = Star(fieldType)
+		}
+		var doc []string
+		if column.Default != nil {
+			doc = append(doc, "default "+*column.Default)
+		}
+		tag := MakeTagsForField(map[string][]string{"sql": {column.Name}})
+		filler.Field(camelCase(column.Name), tag, fieldType, doc...)
+	}
+	return filler.TypeSpec(), nil
+}
@@ -0,0 +1,166 @@
+package asthlp
+
+import (
+	"go/ast"
+	"go/token"
+)
+
+// PqArrayFn is a construction of the `pq.Array` function of github.com/lib/pq
+var PqArrayFn = makeFunc(SimpleSelector("pq", "Array"), 1, false)
+
+type (
+	// SqlArrayDialect defines how the slice is stored in the database
+	SqlArrayDialect int8
+	// SqlArrayType describes the slice type declared with MakeSqlArrayTypes
+	SqlArrayType struct {
+		Name string
+		Elem ast.Expr
+	}
+)
+
+const (
+	// SqlArrayPostgres stores the slice as the Postgres array with pq.Array
+	SqlArrayPostgres SqlArrayDialect = iota
+	// SqlArrayJson stores the slice as the JSON text for the databases without arrays, e.g. MySQL or SQLite
+	SqlArrayJson
+)
+
+// DefaultSqlArrayTypes contains the array types referenced by the generated field types
+var DefaultSqlArrayTypes = []SqlArrayType{
+	{Name: "SqlStringArray", Elem: String},
+	{Name: "SqlIntegerArray", Elem: Int64},
+	{Name: "SqlFloatArray", Elem: Float64},
+	{Name: "SqlBooleanArray", Elem: Bool},
+}
+
+// MakeSqlArrayTypes declares the slice types with the Scan and Value methods of the dialect
+//
+//	type SqlStringArray []string
+//
+//	func (a *SqlStringArray) Scan(src interface{}) error {
+//	    return pq.Array((*[]string)(a)).Scan(src)
+//	}
+//
+//	func (a SqlStringArray) Value() (driver.Value, error) {
+//	    return pq.Array([]string(a)).Value()
+//	}
+func MakeSqlArrayTypes(dialect SqlArrayDialect, arrays ...SqlArrayType) []ast.Decl {
+	var decls = make([]ast.Decl, 0, len(arrays)*3)
+	for _, array := range arrays {
+		var (
+			typeName  = ast.NewIdent(array.Name)
+			sliceType = ArrayType(array.Elem)
+		)
+		decls = append(decls, &ast.GenDecl{Tok: token.TYPE, Specs: []ast.Spec{TypeSpec(array.Name, sliceType)}})
+		switch dialect {
+		case SqlArrayPostgres:
+			decls = append(decls, makePqArrayScan(typeName, sliceType), makePqArrayValue(typeName, sliceType))
+		case SqlArrayJson:
+			decls = append(decls, makeJsonArrayScan(typeName, sliceType), makeJsonArrayValue(typeName, sliceType))
+		default:
+			panic("unknown array dialect")
+		}
+	}
+	return decls
+}
+
+func makePqArrayScan(typeName *ast.Ident, sliceType ast.Expr) ast.Decl {
+	var (
+		a   = ast.NewIdent("a")
+		src = ast.NewIdent("src")
+	)
+	return DeclareFunction(ast.NewIdent("Scan")).
+		Receiver(Field(a.Name, nil, Star(typeName))).
+		Params(Field(src.Name, nil, EmptyInterface)).
+		Results(Field("", nil, ErrorType)).
+		AppendStmt(Return(Call(
+			InlineFunc(Selector(Call(PqArrayFn, ExpressionTypeConvert(a, ParenExpr(Star(sliceType)))), "Scan")),
+			src,
+		))).
+		Decl()
+}
+
+func makePqArrayValue(typeName *ast.Ident, sliceType ast.Expr) ast.Decl {
+	var a = ast.NewIdent("a")
+	return DeclareFunction(ast.NewIdent("Value")).
+		Receiver(Field(a.Name, nil, typeName)).
+		Results(Field("", nil, DriverValue), Field("", nil, ErrorType)).
+		AppendStmt(Return(Call(
+			InlineFunc(Selector(Call(PqArrayFn, ExpressionTypeConvert(a, sliceType)), "Value")),
+		))).
+		Decl()
+}
+
+// makeJsonArrayScan decodes the JSON text, NULL is scanned as the nil slice
+//
+//	func (a *SqlStringArray) Scan(src interface{}) error {
+//	    var repr []byte
+//	    switch val := src.(type) {
+//	    case nil:
+//	        *a = nil
+//	        return nil
+//	    case []byte:
+//	        repr = val
+//	    case string:
+//	        repr = []byte(val)
+//	    default:
+//	        return fmt.Errorf("cannot scan %T into SqlStringArray", src)
+//	    }
+//	    return json.Unmarshal(repr, (*[]string)(a))
+//	}
+func makeJsonArrayScan(typeName *ast.Ident, sliceType ast.Expr) ast.Decl {
+	var (
+		a     = ast.NewIdent("a")
+		src   = ast.NewIdent("src")
+		val   = ast.NewIdent("val")
+		repr  = ast.NewIdent("repr")
+		bytes = ArrayType(Byte)
+		cases = []SwitchCase{MakeSwitchCase(Nil).Body(Assign(VarNames{Star(a)}, Assignment, Nil), Return(Nil))}
+	)
+	cases = append(cases, sqlScanCases(bytes, val, func(x ast.Expr) ast.Stmt {
+		return Assign(VarNames{repr}, Assignment, x)
+	})...)
+	cases = append(cases, MakeSwitchCase().Body(
+		Return(Call(FmtErrorfFn, StringConstant("cannot scan %T into "+typeName.Name).Expr(), src)),
+	))
+	return DeclareFunction(ast.NewIdent("Scan")).
+		Receiver(Field(a.Name, nil, Star(typeName))).
+		Params(Field(src.Name, nil, EmptyInterface)).
+		Results(Field("", nil, ErrorType)).
+		AppendStmt(
+			Var(VariableType(repr.Name, bytes)),
+			MakeTypeSwitch(Assign(VarNames{val}, Definition, ExpressionTypeAssert(src, nil)), cases...),
+			Return(Call(JsonUnmarshal, repr, ExpressionTypeConvert(a, ParenExpr(Star(sliceType))))),
+		).
+		Decl()
+}
+
+// makeJsonArrayValue encodes the slice to the JSON text, the nil slice is stored as NULL
+//
+//	func (a SqlStringArray) Value() (driver.Value, error) {
+//	    if a == nil {
+//	        return nil, nil
+//	    }
+//	    data, err := json.Marshal([]string(a))
+//	    if err != nil {
+//	        return nil, err
+//	    }
+//	    return string(data), nil
+//	}
+func makeJsonArrayValue(typeName *ast.Ident, sliceType ast.Expr) ast.Decl {
+	var (
+		a      = ast.NewIdent("a")
+		data   = ast.NewIdent("data")
+		errVar = ast.NewIdent("err")
+	)
+	return DeclareFunction(ast.NewIdent("Value")).
+		Receiver(Field(a.Name, nil, typeName)).
+		Results(Field("", nil, DriverValue), Field("", nil, ErrorType)).
+		AppendStmt(
+			If(IsNil(a), Return(Nil, Nil)),
+			Assign(VarNames{data, errVar}, Definition, Call(JsonMarshal, ExpressionTypeConvert(a, sliceType))),
+			If(NotNil(errVar), Return(Nil, errVar)),
+			Return(ExpressionTypeConvert(data, String), Nil),
+		).
+		Decl()
+}
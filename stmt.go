@@ -85,11 +85,11 @@ func Block(statements ...ast.Stmt) *ast.BlockStmt {
 //
 //	if <condition> { <body> }
 //
-// nil values will be excluded from Body.List
+// nil values will be excluded from Body.List, panics if the condition is nil
 func If(condition ast.Expr, body ...ast.Stmt) ast.Stmt {
 	return &ast.IfStmt{
 		If:   1,
-		Cond: condition,
+		Cond: checkCondition(condition),
 		Body: Block(body...),
 	}
 }
@@ -98,11 +98,11 @@ func If(condition ast.Expr, body ...ast.Stmt) ast.Stmt {
 //
 //	if <condition> { <body> } else { <alternative> }
 //
-// nil values will be excluded from Body.List
+// nil values will be excluded from Body.List, panics if the condition is nil
 func IfElse(condition ast.Expr, body *ast.BlockStmt, alternative *ast.BlockStmt) ast.Stmt {
 	return &ast.IfStmt{
 		If:   1,
-		Cond: condition,
+		Cond: checkCondition(condition),
 		Body: body,
 		Else: alternative,
 	}
@@ -112,12 +112,12 @@ func IfElse(condition ast.Expr, body *ast.BlockStmt, alternative *ast.BlockStmt)
 //
 //	if <init>; <condition> { <body> }
 //
-// nil values will be excluded from Body.List
+// nil values will be excluded from Body.List, panics if the condition is nil
 func IfInit(initiation ast.Stmt, condition ast.Expr, body ...ast.Stmt) ast.Stmt {
 	return &ast.IfStmt{
 		If:   1,
 		Init: initiation,
-		Cond: condition,
+		Cond: checkCondition(condition),
 		Body: Block(body...),
 	}
 }
@@ -126,7 +126,7 @@ func IfInit(initiation ast.Stmt, condition ast.Expr, body ...ast.Stmt) ast.Stmt
 //
 //	if <init>; <condition> { <body> } else { <alternative> }
 //
-// nil values will be excluded from Body.List
+// nil values will be excluded from Body.List, panics if the condition is nil
 func IfInitElse(initiation ast.Stmt, condition ast.Expr, body *ast.BlockStmt, alternative *ast.BlockStmt) ast.Stmt {
 	if alternative == nil {
 		return IfInit(initiation, condition, body.List...)
@@ -134,12 +134,20 @@ func IfInitElse(initiation ast.Stmt, condition ast.Expr, body *ast.BlockStmt, al
 	return &ast.IfStmt{
 		If:   1,
 		Init: initiation,
-		Cond: condition,
+		Cond: checkCondition(condition),
 		Body: body,
 		Else: alternative,
 	}
 }
 
+// checkCondition makes an invalid `if` statement fail when it is built, not when it is printed
+func checkCondition(condition ast.Expr) ast.Expr {
+	if condition == nil {
+		panic("the condition of the `if` statement is nil")
+	}
+	return condition
+}
+
 // Range represents `for` statement with range expression
 //
 //	for <key>, <value> := range <x> { <body> }
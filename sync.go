@@ -0,0 +1,78 @@
+package asthlp
+
+import "go/ast"
+
+var (
+	// SyncMutex represents the `sync.Mutex` struct
+	SyncMutex = SimpleSelector("sync", "Mutex")
+	// SyncRWMutex represents the `sync.RWMutex` struct
+	SyncRWMutex = SimpleSelector("sync", "RWMutex")
+	// SyncWaitGroup represents the `sync.WaitGroup` struct
+	SyncWaitGroup = SimpleSelector("sync", "WaitGroup")
+	// SyncOnce represents the `sync.Once` struct
+	SyncOnce = SimpleSelector("sync", "Once")
+)
+
+// MutexLockFn is a construction of the `<mu>.Lock` method of sync.Mutex or sync.RWMutex
+func MutexLockFn(mu ast.Expr) CallFunctionDescriber {
+	return MethodOf(mu, "Lock", 0, false)
+}
+
+// MutexUnlockFn is a construction of the `<mu>.Unlock` method of sync.Mutex or sync.RWMutex
+func MutexUnlockFn(mu ast.Expr) CallFunctionDescriber {
+	return MethodOf(mu, "Unlock", 0, false)
+}
+
+// RWMutexRLockFn is a construction of the `<mu>.RLock` method of sync.RWMutex
+func RWMutexRLockFn(mu ast.Expr) CallFunctionDescriber {
+	return MethodOf(mu, "RLock", 0, false)
+}
+
+// RWMutexRUnlockFn is a construction of the `<mu>.RUnlock` method of sync.RWMutex
+func RWMutexRUnlockFn(mu ast.Expr) CallFunctionDescriber {
+	return MethodOf(mu, "RUnlock", 0, false)
+}
+
+// WaitGroupAddFn is a construction of the `<wg>.Add` method of sync.WaitGroup
+func WaitGroupAddFn(wg ast.Expr) CallFunctionDescriber {
+	return MethodOf(wg, "Add", 1, false)
+}
+
+// WaitGroupDoneFn is a construction of the `<wg>.Done` method of sync.WaitGroup
+func WaitGroupDoneFn(wg ast.Expr) CallFunctionDescriber {
+	return MethodOf(wg, "Done", 0, false)
+}
+
+// WaitGroupWaitFn is a construction of the `<wg>.Wait` method of sync.WaitGroup
+func WaitGroupWaitFn(wg ast.Expr) CallFunctionDescriber {
+	return MethodOf(wg, "Wait", 0, false)
+}
+
+// OnceDoFn is a construction of the `<once>.Do` method of sync.Once
+func OnceDoFn(once ast.Expr) CallFunctionDescriber {
+	return MethodOf(once, "Do", 1, false)
+}
+
+// WithLock guards the statements with the mutex until the end of the function
+//
+//	<mu>.Lock()
+//	defer <mu>.Unlock()
+//	<body>
+func WithLock(mu ast.Expr, body ...ast.Stmt) []ast.Stmt {
+	return append([]ast.Stmt{
+		CallStmt(Call(MutexLockFn(mu))),
+		DeferCall(MutexUnlockFn(mu)),
+	}, body...)
+}
+
+// WithRLock guards the statements with the read lock of sync.RWMutex until the end of the function
+//
+//	<mu>.RLock()
+//	defer <mu>.RUnlock()
+//	<body>
+func WithRLock(mu ast.Expr, body ...ast.Stmt) []ast.Stmt {
+	return append([]ast.Stmt{
+		CallStmt(Call(RWMutexRLockFn(mu))),
+		DeferCall(RWMutexRUnlockFn(mu)),
+	}, body...)
+}
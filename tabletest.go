@@ -0,0 +1,146 @@
+package asthlp
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+)
+
+type (
+	// TableTestField describes the argument or the result of the function under test
+	TableTestField struct {
+		Name string
+		Type ast.Expr
+	}
+	// TableTestCase contains the values of the case fields, the keys are the names of arguments and results
+	TableTestCase struct {
+		Name   string
+		Values map[string]ast.Expr
+		// WantErr is considered only if TableTestOptions.WithError is set
+		WantErr bool
+	}
+	TableTestOptions struct {
+		// Name of the test, gets the `Test` prefix
+		Name string
+		// Func is the function under test
+		Func ast.Expr
+		Args []TableTestField
+		// Results are compared with reflect.DeepEqual, the fields of the case get the `want` prefix
+		Results []TableTestField
+		// WithError means the function returns the error as the last result
+		WithError bool
+		Cases     []TableTestCase
+	}
+)
+
+// MakeTableTest creates the table-driven test of the function
+//
+//	func Test<name>(t *testing.T) {
+//	    tests := []struct {
+//	        name    string
+//	        <arg>   <type>
+//	        want<R> <type>
+//	        wantErr bool
+//	    }{
+//	        {name: "<case>", <arg>: <value>, want<R>: <value>},
+//	    }
+//	    for _, tt := range tests {
+//	        t.Run(tt.name, func(t *testing.T) {
+//	            got<R>, err := <func>(tt.<arg>)
+//	            if (err != nil) != tt.wantErr {
+//	                t.Fatalf("<func>() error = %v, wantErr %v", err, tt.wantErr)
+//	            }
+//	            if !reflect.DeepEqual(got<R>, tt.want<R>) {
+//	                t.Errorf("<func>() got<R> = %v, want %v", got<R>, tt.want<R>)
+//	            }
+//	        })
+//	    }
+//	}
+func MakeTableTest(opt TableTestOptions) ast.Decl {
+	if opt.Func == nil {
+		panic("function under test must not be nil")
+	}
+	var (
+		tt       = NewIdent("tt")
+		fields   = []*ast.Field{Field("name", nil, String)}
+		gots     VarNames
+		args     = make([]ast.Expr, 0, len(opt.Args))
+		funcName = types.ExprString(opt.Func)
+	)
+	for _, arg := range opt.Args {
+		fields = append(fields, Field(arg.Name, nil, arg.Type))
+		args = append(args, Selector(tt, arg.Name))
+	}
+	for _, res := range opt.Results {
+		fields = append(fields, Field("want"+exportName(res.Name), nil, res.Type))
+		gots = append(gots, NewIdent("got"+exportName(res.Name)))
+	}
+	if opt.WithError {
+		fields = append(fields, Field("wantErr", nil, Bool))
+		gots = append(gots, NewIdent("err"))
+	}
+
+	var cases = make([]ast.Expr, 0, len(opt.Cases))
+	for _, c := range opt.Cases {
+		var lit = StructLiteral(nil).FillKeyValue("name", StringConstant(c.Name).Expr())
+		for _, arg := range opt.Args {
+			if val, ok := c.Values[arg.Name]; ok {
+				lit.FillKeyValue(arg.Name, val)
+			}
+		}
+		for _, res := range opt.Results {
+			if val, ok := c.Values[res.Name]; ok {
+				lit.FillKeyValue("want"+exportName(res.Name), val)
+			}
+		}
+		if opt.WithError && c.WantErr {
+			lit.FillKeyValue("wantErr", True)
+		}
+		cases = append(cases, lit.Expr())
+	}
+
+	var (
+		call = &ast.CallExpr{Fun: opt.Func, Args: args}
+		body []ast.Stmt
+	)
+	if len(gots) == 0 {
+		body = append(body, CallStmt(call))
+	} else {
+		body = append(body, Assign(gots, Definition, call))
+	}
+	if opt.WithError {
+		var wantErr = Selector(tt, "wantErr")
+		body = append(body, If(
+			NotEqual(ParenExpr(NotNil(NewIdent("err"))), wantErr),
+			CallStmt(Call(TFatalfFn, StringConstant(funcName+"() error = %v, wantErr %v").Expr(), NewIdent("err"), wantErr)),
+		))
+	}
+	for _, res := range opt.Results {
+		var (
+			got  = NewIdent("got" + exportName(res.Name))
+			want = Selector(tt, "want"+exportName(res.Name))
+		)
+		body = append(body, If(
+			Not(Call(ReflectDeepEqualFn, got, want)),
+			CallStmt(Call(TErrorfFn, StringConstant(funcName+"() "+got.Name+" = %v, want %v").Expr(), got, want)),
+		))
+	}
+
+	var tests = &ast.CompositeLit{
+		Type: ArrayType(StructType(fields...)),
+		Elts: cases,
+	}
+	return DeclareTest(opt.Name).
+		AppendStmt(
+			Assign(VarNames{NewIdent("tests")}, Definition, tests),
+			Range(true, "_", "tt", NewIdent("tests"), MakeSubTest(Selector(tt, "name"), body...)),
+		).
+		Decl()
+}
+
+func exportName(name string) string {
+	if name == "" {
+		return ""
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
@@ -0,0 +1,250 @@
+package asthlp
+
+import (
+	"go/ast"
+	"go/token"
+)
+
+var (
+	// TestingT represents the `testing.T` struct
+	TestingT = SimpleSelector("testing", "T")
+	// TestingB represents the `testing.B` struct
+	TestingB = SimpleSelector("testing", "B")
+	// TestingF represents the `testing.F` struct
+	TestingF = SimpleSelector("testing", "F")
+
+	// TRunFn is a construction of the `t.Run` function
+	TRunFn = makeFunc(SimpleSelector("t", "Run"), 2, false)
+	// THelperFn is a construction of the `t.Helper` function
+	THelperFn = makeFunc(SimpleSelector("t", "Helper"), 0, false)
+	// TParallelFn is a construction of the `t.Parallel` function
+	TParallelFn = makeFunc(SimpleSelector("t", "Parallel"), 0, false)
+	// TErrorFn is a construction of the `t.Error` function
+	TErrorFn = makeFunc(SimpleSelector("t", "Error"), 0, true)
+	// TErrorfFn is a construction of the `t.Errorf` function, the format must be a string literal
+	TErrorfFn = makeFunc(SimpleSelector("t", "Errorf"), 1, true).WithArgumentKinds(ArgumentString)
+	// TFatalFn is a construction of the `t.Fatal` function
+	TFatalFn = makeFunc(SimpleSelector("t", "Fatal"), 0, true)
+	// TFatalfFn is a construction of the `t.Fatalf` function, the format must be a string literal
+	TFatalfFn = makeFunc(SimpleSelector("t", "Fatalf"), 1, true).WithArgumentKinds(ArgumentString)
+	// TSkipFn is a construction of the `t.Skip` function
+	TSkipFn = makeFunc(SimpleSelector("t", "Skip"), 0, true)
+
+	// BReportAllocsFn is a construction of the `b.ReportAllocs` function
+	BReportAllocsFn = makeFunc(SimpleSelector("b", "ReportAllocs"), 0, false)
+	// BResetTimerFn is a construction of the `b.ResetTimer` function
+	BResetTimerFn = makeFunc(SimpleSelector("b", "ResetTimer"), 0, false)
+	// FAddFn is a construction of the `f.Add` function
+	FAddFn = makeFunc(SimpleSelector("f", "Add"), 1, true)
+	// FFuzzFn is a construction of the `f.Fuzz` function
+	FFuzzFn = makeFunc(SimpleSelector("f", "Fuzz"), 1, false)
+
+	// ReflectDeepEqualFn is a construction of the `reflect.DeepEqual` function
+	ReflectDeepEqualFn = makeFunc(SimpleSelector("reflect", "DeepEqual"), 2, false)
+
+	// RequireNoErrorFn is a construction of the `require.NoError` function of github.com/stretchr/testify
+	RequireNoErrorFn = makeFunc(SimpleSelector("require", "NoError"), 2, true)
+	// RequireErrorFn is a construction of the `require.Error` function of github.com/stretchr/testify
+	RequireErrorFn = makeFunc(SimpleSelector("require", "Error"), 2, true)
+	// RequireEqualFn is a construction of the `require.Equal` function of github.com/stretchr/testify
+	RequireEqualFn = makeFunc(SimpleSelector("require", "Equal"), 3, true)
+	// AssertNoErrorFn is a construction of the `assert.NoError` function of github.com/stretchr/testify
+	AssertNoErrorFn = makeFunc(SimpleSelector("assert", "NoError"), 2, true)
+	// AssertErrorFn is a construction of the `assert.Error` function of github.com/stretchr/testify
+	AssertErrorFn = makeFunc(SimpleSelector("assert", "Error"), 2, true)
+	// AssertEqualFn is a construction of the `assert.Equal` function of github.com/stretchr/testify
+	AssertEqualFn = makeFunc(SimpleSelector("assert", "Equal"), 3, true)
+	// AssertTrueFn is a construction of the `assert.True` function of github.com/stretchr/testify
+	AssertTrueFn = makeFunc(SimpleSelector("assert", "True"), 2, true)
+)
+
+// TRunMethod is a construction of the `<t>.Run` method of testing.T called on the given receiver
+func TRunMethod(t ast.Expr) CallFunctionDescriber {
+	return MethodOf(t, "Run", 2, false)
+}
+
+// THelperMethod is a construction of the `<t>.Helper` method of testing.T called on the given receiver
+func THelperMethod(t ast.Expr) CallFunctionDescriber {
+	return MethodOf(t, "Helper", 0, false)
+}
+
+// TParallelMethod is a construction of the `<t>.Parallel` method of testing.T called on the given receiver
+func TParallelMethod(t ast.Expr) CallFunctionDescriber {
+	return MethodOf(t, "Parallel", 0, false)
+}
+
+// TErrorMethod is a construction of the `<t>.Error` method of testing.T called on the given receiver
+func TErrorMethod(t ast.Expr) CallFunctionDescriber {
+	return MethodOf(t, "Error", 0, true)
+}
+
+// TErrorfMethod is a construction of the `<t>.Errorf` method of testing.T called on the given receiver, the format must be a string literal
+func TErrorfMethod(t ast.Expr) CallFunctionDescriber {
+	return MethodOf(t, "Errorf", 1, true).WithArgumentKinds(ArgumentString)
+}
+
+// TFatalMethod is a construction of the `<t>.Fatal` method of testing.T called on the given receiver
+func TFatalMethod(t ast.Expr) CallFunctionDescriber {
+	return MethodOf(t, "Fatal", 0, true)
+}
+
+// TFatalfMethod is a construction of the `<t>.Fatalf` method of testing.T called on the given receiver, the format must be a string literal
+func TFatalfMethod(t ast.Expr) CallFunctionDescriber {
+	return MethodOf(t, "Fatalf", 1, true).WithArgumentKinds(ArgumentString)
+}
+
+// TSkipMethod is a construction of the `<t>.Skip` method of testing.T called on the given receiver
+func TSkipMethod(t ast.Expr) CallFunctionDescriber {
+	return MethodOf(t, "Skip", 0, true)
+}
+
+// BReportAllocsMethod is a construction of the `<b>.ReportAllocs` method of testing.B called on the given receiver
+func BReportAllocsMethod(b ast.Expr) CallFunctionDescriber {
+	return MethodOf(b, "ReportAllocs", 0, false)
+}
+
+// BResetTimerMethod is a construction of the `<b>.ResetTimer` method of testing.B called on the given receiver
+func BResetTimerMethod(b ast.Expr) CallFunctionDescriber {
+	return MethodOf(b, "ResetTimer", 0, false)
+}
+
+// FAddMethod is a construction of the `<f>.Add` method of testing.F called on the given receiver
+func FAddMethod(f ast.Expr) CallFunctionDescriber {
+	return MethodOf(f, "Add", 1, true)
+}
+
+// FFuzzMethod is a construction of the `<f>.Fuzz` method of testing.F called on the given receiver
+func FFuzzMethod(f ast.Expr) CallFunctionDescriber {
+	return MethodOf(f, "Fuzz", 1, false)
+}
+
+type (
+	TestFuncDecl interface {
+		Comments(...string) TestFuncDecl
+		AppendStmt(...ast.Stmt) TestFuncDecl
+		SubTest(name string, body ...ast.Stmt) TestFuncDecl
+		Decl() ast.Decl
+	}
+	testFuncDecl struct {
+		fn FuncDecl
+	}
+)
+
+// DeclareTest creates the test function, the name gets the `Test` prefix
+//
+//	func Test<name>(t *testing.T) {
+//	    <statements and subtests>
+//	}
+func DeclareTest(name string) TestFuncDecl {
+	return &testFuncDecl{
+		fn: DeclareFunction(ast.NewIdent("Test" + name)).Params(Field("t", nil, Star(TestingT))),
+	}
+}
+
+func (f *testFuncDecl) Comments(comments ...string) TestFuncDecl {
+	f.fn.Comments(comments...)
+	return f
+}
+
+func (f *testFuncDecl) AppendStmt(stmt ...ast.Stmt) TestFuncDecl {
+	f.fn.AppendStmt(stmt...)
+	return f
+}
+
+func (f *testFuncDecl) SubTest(name string, body ...ast.Stmt) TestFuncDecl {
+	f.fn.AppendStmt(MakeSubTest(StringConstant(name).Expr(), body...))
+	return f
+}
+
+func (f *testFuncDecl) Decl() ast.Decl {
+	return f.fn.Decl()
+}
+
+// MakeSubTest creates the subtest statement
+//
+//	t.Run(<name>, func(t *testing.T) {
+//	    <body>
+//	})
+func MakeSubTest(name ast.Expr, body ...ast.Stmt) ast.Stmt {
+	var fn = DeclareFunction(nil).
+		Params(Field("t", nil, Star(TestingT))).
+		AppendStmt(body...)
+	return CallStmt(Call(TRunFn, name, fn.Lit()))
+}
+
+// MakeBenchmark creates the benchmark function, the name gets the `Benchmark` prefix
+//
+//	func Benchmark<name>(b *testing.B) {
+//	    <setup>
+//	    b.ReportAllocs()
+//	    b.ResetTimer()
+//	    for i := 0; i < b.N; i++ {
+//	        <body>
+//	    }
+//	}
+func MakeBenchmark(name string, setup []ast.Stmt, body ...ast.Stmt) ast.Decl {
+	var i = NewIdent("i")
+	return DeclareFunction(ast.NewIdent("Benchmark"+name)).
+		Params(Field("b", nil, Star(TestingB))).
+		AppendStmt(setup...).
+		AppendStmt(
+			CallStmt(Call(BReportAllocsFn)),
+			CallStmt(Call(BResetTimerFn)),
+			&ast.ForStmt{
+				Init: Assign(VarNames{i}, Definition, IntegerConstant(0).Expr()),
+				Cond: Binary(i, SimpleSelector("b", "N"), token.LSS),
+				Post: Increment(i),
+				Body: Block(body...),
+			},
+		).
+		Decl()
+}
+
+// MakeFuzzTest creates the fuzz test function, the name gets the `Fuzz` prefix.
+// Each seed is the list of arguments of f.Add, the params are the arguments of the fuzz target following `t *testing.T`
+//
+//	func Fuzz<name>(f *testing.F) {
+//	    f.Add(<seed>...)
+//	    f.Fuzz(func(t *testing.T, <params>) {
+//	        <body>
+//	    })
+//	}
+func MakeFuzzTest(name string, seeds [][]ast.Expr, params []*ast.Field, body ...ast.Stmt) ast.Decl {
+	var fn = DeclareFunction(ast.NewIdent("Fuzz" + name)).
+		Params(Field("f", nil, Star(TestingF)))
+	for _, seed := range seeds {
+		fn.AppendStmt(CallStmt(Call(FAddFn, seed...)))
+	}
+	var target = DeclareFunction(nil).
+		Params(append([]*ast.Field{Field("t", nil, Star(TestingT))}, params...)...).
+		AppendStmt(body...)
+	return fn.AppendStmt(CallStmt(Call(FFuzzFn, target.Lit()))).Decl()
+}
+
+// MakeExample creates the example function, the name gets the `Example` prefix (e.g. `Parse` or `Decoder_Decode`).
+// The output lines are attached as the trailing comment of the body, so the example gets verified by go test.
+// If the output is nil, the example is compiled but not executed
+//
+//	func Example<name>() {
+//	    <body>
+//	    // Output:
+//	    // <output>
+//	}
+func MakeExample(name string, output []string, body ...ast.Stmt) ast.Decl {
+	var fn = DeclareFunction(ast.NewIdent("Example" + name)).AppendStmt(body...)
+	if output != nil {
+		fn.AppendStmt(outputComment("Output:"))
+		for _, line := range output {
+			fn.AppendStmt(outputComment(line))
+		}
+	}
+	return fn.Decl()
+}
+
+// outputComment avoids the trailing space of CommentStmt on empty lines, go test compares the output exactly
+func outputComment(line string) ast.Stmt {
+	if line == "" {
+		return &ast.ExprStmt{X: &ast.BasicLit{Kind: token.COMMENT, Value: "//"}}
+	}
+	return CommentStmt(line)
+}
@@ -0,0 +1,73 @@
+package asthlp
+
+import (
+	"go/ast"
+	"go/token"
+)
+
+var (
+	// TimeDuration represents the `time.Duration` data type
+	TimeDuration = SimpleSelector("time", "Duration")
+
+	// TimeNanosecond represents the `time.Nanosecond` constant
+	TimeNanosecond = SimpleSelector("time", "Nanosecond")
+	// TimeMicrosecond represents the `time.Microsecond` constant
+	TimeMicrosecond = SimpleSelector("time", "Microsecond")
+	// TimeMillisecond represents the `time.Millisecond` constant
+	TimeMillisecond = SimpleSelector("time", "Millisecond")
+	// TimeSecond represents the `time.Second` constant
+	TimeSecond = SimpleSelector("time", "Second")
+	// TimeMinute represents the `time.Minute` constant
+	TimeMinute = SimpleSelector("time", "Minute")
+	// TimeHour represents the `time.Hour` constant
+	TimeHour = SimpleSelector("time", "Hour")
+
+	// TimeRFC3339 represents the `time.RFC3339` layout
+	TimeRFC3339 = SimpleSelector("time", "RFC3339")
+	// TimeRFC3339Nano represents the `time.RFC3339Nano` layout
+	TimeRFC3339Nano = SimpleSelector("time", "RFC3339Nano")
+)
+
+// TimeFormatFn is a construction of the `<t>.Format` method of time.Time
+func TimeFormatFn(t ast.Expr) CallFunctionDescriber {
+	return MethodOf(t, "Format", 1, false)
+}
+
+// TimeUTCFn is a construction of the `<t>.UTC` method of time.Time
+func TimeUTCFn(t ast.Expr) CallFunctionDescriber {
+	return MethodOf(t, "UTC", 0, false)
+}
+
+// TimeUnixMethodFn is a construction of the `<t>.Unix` method of time.Time
+func TimeUnixMethodFn(t ast.Expr) CallFunctionDescriber {
+	return MethodOf(t, "Unix", 0, false)
+}
+
+// TimeIsZeroFn is a construction of the `<t>.IsZero` method of time.Time
+func TimeIsZeroFn(t ast.Expr) CallFunctionDescriber {
+	return MethodOf(t, "IsZero", 0, false)
+}
+
+// TimeSubFn is a construction of the `<t>.Sub` method of time.Time
+func TimeSubFn(t ast.Expr) CallFunctionDescriber {
+	return MethodOf(t, "Sub", 1, false)
+}
+
+// TimeAddFn is a construction of the `<t>.Add` method of time.Time
+func TimeAddFn(t ast.Expr) CallFunctionDescriber {
+	return MethodOf(t, "Add", 1, false)
+}
+
+// MakeDuration represents the duration expression, use constants like TimeSecond as the unit
+//
+//	<count> * <unit> e.g. 5 * time.Second
+func MakeDuration(count int64, unit ast.Expr) ast.Expr {
+	return Binary(IntegerConstant(count).Expr(), unit, token.MUL)
+}
+
+// MakeRFC3339Format represents formatting of the time in UTC
+//
+//	<t>.UTC().Format(time.RFC3339)
+func MakeRFC3339Format(t ast.Expr) ast.Expr {
+	return Call(TimeFormatFn(Call(TimeUTCFn(t))), TimeRFC3339)
+}
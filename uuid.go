@@ -0,0 +1,17 @@
+package asthlp
+
+var (
+	// UuidNil represents the `uuid.Nil` value of github.com/google/uuid
+	UuidNil = SimpleSelector("uuid", "Nil")
+
+	// UuidNewFn is a construction of the `uuid.New` function
+	UuidNewFn = makeFunc(SimpleSelector("uuid", "New"), 0, false)
+	// UuidNewStringFn is a construction of the `uuid.NewString` function
+	UuidNewStringFn = makeFunc(SimpleSelector("uuid", "NewString"), 0, false)
+	// UuidParseFn is a construction of the `uuid.Parse` function
+	UuidParseFn = makeFunc(SimpleSelector("uuid", "Parse"), 1, false)
+	// UuidMustParseFn is a construction of the `uuid.MustParse` function
+	UuidMustParseFn = makeFunc(SimpleSelector("uuid", "MustParse"), 1, false)
+	// UuidParseBytesFn is a construction of the `uuid.ParseBytes` function
+	UuidParseBytesFn = makeFunc(SimpleSelector("uuid", "ParseBytes"), 1, false)
+)
@@ -0,0 +1,146 @@
+package asthlp
+
+import (
+	"go/ast"
+	"strings"
+)
+
+type (
+	// SqlValueOptions describes the methods created with MakeSqlScanner and MakeSqlValuer
+	SqlValueOptions struct {
+		// Representation is the type the value is stored as: String, Int64, Float64, Bool, ArrayType(Byte) or TimeTime
+		Representation ast.Expr
+		// Decode is the function converting the representation to the value, it returns the value and the error.
+		// The representation is converted to the type if nil
+		Decode ast.Expr
+		// Encode is the function converting the value to the representation, it returns the representation and the error.
+		// The value is converted to the representation if nil
+		Encode ast.Expr
+		// Nullable makes Scan set the zero value on NULL instead of returning the error
+		Nullable bool
+	}
+)
+
+// MakeSqlValueMethods creates the Scan and Value methods implementing sql.Scanner and driver.Valuer for the type,
+// the type must be convertible to the representation unless Decode and Encode are set
+func MakeSqlValueMethods(name string, opts SqlValueOptions) []ast.Decl {
+	return []ast.Decl{
+		MakeSqlScanner(name, opts),
+		MakeSqlValuer(name, opts),
+	}
+}
+
+// MakeSqlScanner creates the Scan method implementing sql.Scanner, strings and bytes are accepted
+// interchangeably for the String and ArrayType(Byte) representations
+//
+//	func (m *Money) Scan(src interface{}) error {
+//	    var repr string
+//	    switch val := src.(type) {
+//	    case string:
+//	        repr = val
+//	    case []byte:
+//	        repr = string(val)
+//	    default:
+//	        return fmt.Errorf("cannot scan %T into Money", src)
+//	    }
+//	    decoded, err := <Decode>(repr)
+//	    if err != nil {
+//	        return err
+//	    }
+//	    *m = decoded
+//	    return nil
+//	}
+func MakeSqlScanner(name string, opts SqlValueOptions) ast.Decl {
+	var (
+		typeName = ast.NewIdent(name)
+		recv     = sqlValueReceiver(name)
+		src      = ast.NewIdent("src")
+		repr     = ast.NewIdent("repr")
+		val      = ast.NewIdent("val")
+		decoded  = ast.NewIdent("decoded")
+		zero     = ast.NewIdent("zero")
+		errVar   = ast.NewIdent("err")
+		cases    []SwitchCase
+	)
+	if opts.Nullable {
+		cases = append(cases, MakeSwitchCase(Nil).Body(
+			Var(VariableType(zero.Name, typeName)),
+			Assign(VarNames{Star(recv)}, Assignment, zero),
+			Return(Nil),
+		))
+	}
+	cases = append(cases, sqlScanCases(opts.Representation, val, func(x ast.Expr) ast.Stmt {
+		return Assign(VarNames{repr}, Assignment, x)
+	})...)
+	cases = append(cases, MakeSwitchCase().Body(
+		Return(Call(FmtErrorfFn, StringConstant("cannot scan %T into "+name).Expr(), src)),
+	))
+
+	var fn = DeclareFunction(ast.NewIdent("Scan")).
+		Receiver(Field(recv.Name, nil, Star(typeName))).
+		Params(Field(src.Name, nil, EmptyInterface)).
+		Results(Field("", nil, ErrorType)).
+		AppendStmt(
+			Var(VariableType(repr.Name, opts.Representation)),
+			MakeTypeSwitch(Assign(VarNames{val}, Definition, ExpressionTypeAssert(src, nil)), cases...),
+		)
+	if opts.Decode == nil {
+		return fn.AppendStmt(
+			Assign(VarNames{Star(recv)}, Assignment, ExpressionTypeConvert(repr, typeName)),
+			Return(Nil),
+		).Decl()
+	}
+	return fn.AppendStmt(
+		Assign(VarNames{decoded, errVar}, Definition, Call(InlineFunc(opts.Decode), repr)),
+		If(NotNil(errVar), Return(errVar)),
+		Assign(VarNames{Star(recv)}, Assignment, decoded),
+		Return(Nil),
+	).Decl()
+}
+
+// MakeSqlValuer creates the Value method implementing driver.Valuer
+//
+//	func (m Money) Value() (driver.Value, error) {
+//	    return <Encode>(m)
+//	}
+func MakeSqlValuer(name string, opts SqlValueOptions) ast.Decl {
+	var (
+		recv   = sqlValueReceiver(name)
+		result = Return(ExpressionTypeConvert(recv, opts.Representation), Nil)
+	)
+	if opts.Encode != nil {
+		result = Return(Call(InlineFunc(opts.Encode), recv))
+	}
+	return DeclareFunction(ast.NewIdent("Value")).
+		Receiver(Field(recv.Name, nil, ast.NewIdent(name))).
+		Results(Field("", nil, DriverValue), Field("", nil, ErrorType)).
+		AppendStmt(result).
+		Decl()
+}
+
+// sqlScanCases returns the cases of the type switch over the scanned value receiving the representation,
+// strings and bytes are accepted interchangeably
+func sqlScanCases(repr, val ast.Expr, set func(ast.Expr) ast.Stmt) []SwitchCase {
+	var cases = []SwitchCase{MakeSwitchCase(repr).Body(set(val))}
+	switch {
+	case isStringType(repr):
+		cases = append(cases, MakeSwitchCase(ArrayType(Byte)).Body(set(ExpressionTypeConvert(val, String))))
+	case isByteSliceType(repr):
+		cases = append(cases, MakeSwitchCase(String).Body(set(ExpressionTypeConvert(val, ArrayType(Byte)))))
+	}
+	return cases
+}
+
+// sqlValueReceiver names the receiver after the first letter of the type
+func sqlValueReceiver(name string) *ast.Ident {
+	return ast.NewIdent(strings.ToLower(name[:1]))
+}
+
+func isByteSliceType(t ast.Expr) bool {
+	array, ok := t.(*ast.ArrayType)
+	if !ok || array.Len != nil {
+		return false
+	}
+	ident, ok := array.Elt.(*ast.Ident)
+	return ok && (ident.Name == Byte.Name || ident.Name == UInt8.Name)
+}